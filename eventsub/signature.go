@@ -0,0 +1,48 @@
+// Package eventsub verifies Twitch EventSub webhook messages. It is public so
+// webhook receivers in other modules can share the checks the provider uses.
+package eventsub
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+)
+
+// Headers Twitch sets on every EventSub webhook message.
+const (
+	MessageIDHeader        = "Twitch-Eventsub-Message-Id"
+	MessageTimestampHeader = "Twitch-Eventsub-Message-Timestamp"
+	MessageSignatureHeader = "Twitch-Eventsub-Message-Signature"
+	MessageTypeHeader      = "Twitch-Eventsub-Message-Type"
+)
+
+// Length limits Twitch places on webhook secrets.
+const (
+	MinSecretLength = 10
+	MaxSecretLength = 100
+)
+
+// SignMessage returns the Twitch-Eventsub-Message-Signature value for a
+// message, in the form "sha256=<hex digest>".
+func SignMessage(secret, messageID, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(messageID))
+	mac.Write([]byte(timestamp))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature reports whether a webhook message was signed with secret.
+// The body must be the raw request body, before any JSON decoding. Messages
+// missing any of the signing headers are rejected.
+func VerifySignature(secret string, header http.Header, body []byte) bool {
+	if header.Get(MessageIDHeader) == "" || header.Get(MessageTimestampHeader) == "" || header.Get(MessageSignatureHeader) == "" {
+		return false
+	}
+
+	expected := SignMessage(secret, header.Get(MessageIDHeader), header.Get(MessageTimestampHeader), body)
+
+	return hmac.Equal([]byte(expected), []byte(header.Get(MessageSignatureHeader)))
+}
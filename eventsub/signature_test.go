@@ -0,0 +1,104 @@
+package eventsub
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestVerifySignature(t *testing.T) {
+	const (
+		secret    = "0123456789abcdef"
+		messageID = "e76c6bd4-55c9-4987-8304-da1588d8988b"
+		timestamp = "2019-11-16T10:11:12.634234626Z"
+		signature = "sha256=2410aabeda72b832b3bac51adaa72f1dd196a98c33128f97adbf72bec017a58b"
+	)
+
+	body := []byte(`{"event":"test"}`)
+
+	if got := SignMessage(secret, messageID, timestamp, body); got != signature {
+		t.Fatalf("SignMessage() = %q, want %q", got, signature)
+	}
+
+	signedHeader := func() http.Header {
+		header := http.Header{}
+		header.Set(MessageIDHeader, messageID)
+		header.Set(MessageTimestampHeader, timestamp)
+		header.Set(MessageSignatureHeader, signature)
+
+		return header
+	}
+
+	tests := []struct {
+		name   string
+		secret string
+		header func() http.Header
+		body   []byte
+		want   bool
+	}{
+		{
+			name:   "valid",
+			secret: secret,
+			header: signedHeader,
+			body:   body,
+			want:   true,
+		},
+		{
+			name:   "tampered body",
+			secret: secret,
+			header: signedHeader,
+			body:   []byte(`{"event":"tampered"}`),
+			want:   false,
+		},
+		{
+			name:   "wrong secret",
+			secret: "fedcba9876543210",
+			header: signedHeader,
+			body:   body,
+			want:   false,
+		},
+		{
+			name:   "missing signature header",
+			secret: secret,
+			header: func() http.Header {
+				header := signedHeader()
+				header.Del(MessageSignatureHeader)
+
+				return header
+			},
+			body: body,
+			want: false,
+		},
+		{
+			name:   "missing message id header",
+			secret: secret,
+			header: func() http.Header {
+				header := signedHeader()
+				header.Del(MessageIDHeader)
+
+				return header
+			},
+			body: body,
+			want: false,
+		},
+		{
+			name:   "missing timestamp header",
+			secret: secret,
+			header: func() http.Header {
+				header := signedHeader()
+				header.Del(MessageTimestampHeader)
+
+				return header
+			},
+			body: body,
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VerifySignature(tt.secret, tt.header(), tt.body); got != tt.want {
+				t.Errorf("VerifySignature() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
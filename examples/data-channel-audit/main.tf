@@ -0,0 +1,43 @@
+terraform {
+  required_providers {
+    twitch = {
+      source = "ellg/twitch"
+    }
+  }
+}
+
+variable client_id {
+  type = string
+  sensitive = true
+}
+
+variable access_token {
+  type = string
+  sensitive = true
+}
+
+provider "twitch" {
+  client_id    = var.client_id
+  access_token = var.access_token
+}
+
+data "twitch_channel_editors" "channel" {
+  broadcaster_id = "12345678"
+}
+
+data "twitch_channel_followers" "channel" {
+  broadcaster_id = "12345678"
+  limit          = 50
+}
+
+output "editors" {
+  value = [for editor in data.twitch_channel_editors.channel.editors : editor.user_name]
+}
+
+output "follower_total" {
+  value = data.twitch_channel_followers.channel.total
+}
+
+output "recent_followers" {
+  value = [for follower in data.twitch_channel_followers.channel.followers : follower.user_login]
+}
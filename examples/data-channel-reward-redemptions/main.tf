@@ -0,0 +1,40 @@
+terraform {
+  required_providers {
+    twitch = {
+      source = "ellg/twitch"
+    }
+  }
+}
+
+variable client_id {
+  type = string
+  sensitive = true
+}
+
+variable access_token {
+  type = string
+  sensitive = true
+}
+
+provider "twitch" {
+  client_id    = var.client_id
+  access_token = var.access_token
+}
+
+resource "twitch_channel_reward" "song_request" {
+  broadcaster_id         = "12345678"
+  title                  = "Song request"
+  cost                   = 500
+  prompt                 = "Link a song"
+  is_enabled             = true
+  on_destroy_redemptions = "refund"
+}
+
+data "twitch_channel_reward_redemptions" "pending" {
+  broadcaster_id = twitch_channel_reward.song_request.broadcaster_id
+  reward_id      = twitch_channel_reward.song_request.id
+}
+
+output "song_queue" {
+  value = [for redemption in data.twitch_channel_reward_redemptions.pending.redemptions : redemption.user_input]
+}
@@ -0,0 +1,39 @@
+terraform {
+  required_providers {
+    twitch = {
+      source = "ellg/twitch"
+    }
+  }
+}
+
+variable client_id {
+  type = string
+  sensitive = true
+}
+
+variable access_token {
+  type = string
+  sensitive = true
+}
+
+provider "twitch" {
+  client_id    = var.client_id
+  access_token = var.access_token
+}
+
+data "twitch_content_classification_labels" "german" {
+  locale = "de-DE"
+}
+
+resource "twitch_channel" "channel" {
+  id      = "12345678"
+  title   = "Late night horror"
+  tags    = ["Horror", "English"]
+  game_id = "509658"
+
+  content_classification_labels = ["ProfanityVulgarity", "ViolentGraphic"]
+}
+
+output "label_names" {
+  value = { for label in data.twitch_content_classification_labels.german.labels : label.id => label.name }
+}
@@ -0,0 +1,31 @@
+terraform {
+  required_providers {
+    twitch = {
+      source = "ellg/twitch"
+    }
+  }
+}
+
+variable client_id {
+  type = string
+  sensitive = true
+}
+
+variable access_token {
+  type = string
+  sensitive = true
+}
+
+provider "twitch" {
+  client_id    = var.client_id
+  access_token = var.access_token
+}
+
+data "twitch_eventsub_subscriptions" "all" {}
+
+check "eventsub_cost" {
+  assert {
+    condition     = data.twitch_eventsub_subscriptions.all.total_cost < data.twitch_eventsub_subscriptions.all.max_total_cost * 0.9
+    error_message = "EventSub subscriptions are above 90% of the cost ceiling."
+  }
+}
@@ -0,0 +1,36 @@
+terraform {
+  required_providers {
+    twitch = {
+      source = "ellg/twitch"
+    }
+  }
+}
+
+variable client_id {
+  type = string
+  sensitive = true
+}
+
+variable access_token {
+  type = string
+  sensitive = true
+}
+
+provider "twitch" {
+  client_id    = var.client_id
+  access_token = var.access_token
+}
+
+data "twitch_search_categories" "pokemon" {
+  query = "pokemon"
+  limit = 5
+}
+
+data "twitch_game" "pokemon" {
+  name  = "Pokemon Scarlet and Violet"
+  fuzzy = true
+}
+
+output "pokemon_candidates" {
+  value = [for category in data.twitch_search_categories.pokemon.categories : category.name]
+}
@@ -0,0 +1,40 @@
+terraform {
+  required_providers {
+    twitch = {
+      source = "ellg/twitch"
+    }
+  }
+}
+
+variable client_id {
+  type = string
+  sensitive = true
+}
+
+variable access_token {
+  type = string
+  sensitive = true
+}
+
+provider "twitch" {
+  client_id    = var.client_id
+  access_token = var.access_token
+}
+
+data "twitch_stream" "channel" {
+  user_login = "ellg"
+}
+
+resource "twitch_channel" "channel" {
+  id      = "12345678"
+  title   = "Offline category swap"
+  tags    = ["English"]
+  game_id = "509658"
+
+  lifecycle {
+    precondition {
+      condition     = !data.twitch_stream.channel.is_live
+      error_message = "Refusing to change the channel while it is live."
+    }
+  }
+}
@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    twitch = {
+      source = "ellg/twitch"
+    }
+  }
+}
+
+variable client_id {
+  type = string
+  sensitive = true
+}
+
+variable access_token {
+  type = string
+  sensitive = true
+}
+
+provider "twitch" {
+  client_id    = var.client_id
+  access_token = var.access_token
+}
+
+data "twitch_top_games" "trending" {
+  limit = 10
+}
+
+output "trending_games" {
+  value = { for game in data.twitch_top_games.trending.games : game.id => game.name }
+}
@@ -0,0 +1,36 @@
+terraform {
+  required_providers {
+    twitch = {
+      source = "ellg/twitch"
+    }
+  }
+}
+
+variable client_id {
+  type = string
+  sensitive = true
+}
+
+variable access_token {
+  type = string
+  sensitive = true
+}
+
+provider "twitch" {
+  client_id    = var.client_id
+  access_token = var.access_token
+}
+
+data "twitch_user" "me" {}
+
+data "twitch_user" "ellg" {
+  login = "ellg"
+}
+
+data "twitch_users" "mods" {
+  logins = ["ellg", "twitchdev"]
+}
+
+output "ellg_profile_image" {
+  value = data.twitch_user.ellg.profile_image_url
+}
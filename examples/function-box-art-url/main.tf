@@ -0,0 +1,40 @@
+terraform {
+  required_providers {
+    twitch = {
+      source = "ellg/twitch"
+    }
+  }
+}
+
+variable client_id {
+  type = string
+  sensitive = true
+}
+
+variable access_token {
+  type = string
+  sensitive = true
+}
+
+provider "twitch" {
+  client_id    = var.client_id
+  access_token = var.access_token
+}
+
+data "twitch_game" "programming" {
+  name           = "Software and Game Development"
+  box_art_width  = 600
+  box_art_height = 800
+}
+
+output "overlay_box_art" {
+  value = data.twitch_game.programming.rendered_box_art_url
+}
+
+output "thumbnail_box_art" {
+  value = data.twitch_game.programming.box_art_urls["52x72"]
+}
+
+output "banner_box_art" {
+  value = provider::twitch::box_art_url(data.twitch_game.programming.box_art_url, 1200, 1600)
+}
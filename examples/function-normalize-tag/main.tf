@@ -0,0 +1,44 @@
+terraform {
+  required_providers {
+    twitch = {
+      source = "ellg/twitch"
+    }
+  }
+}
+
+variable client_id {
+  type = string
+  sensitive = true
+}
+
+variable access_token {
+  type = string
+  sensitive = true
+}
+
+provider "twitch" {
+  client_id    = var.client_id
+  access_token = var.access_token
+}
+
+locals {
+  topics = ["Game Dev", "Open-Source", "Terraform & Go"]
+  tags   = [for topic in local.topics : provider::twitch::normalize_tag(topic)]
+
+  reward_color = "#9146FF"
+}
+
+check "reward_color" {
+  assert {
+    condition     = provider::twitch::is_valid_color(local.reward_color)
+    error_message = "Reward colors must be #RRGGBB hex colors."
+  }
+}
+
+output "tags" {
+  value = local.tags
+}
+
+output "kappa_url" {
+  value = provider::twitch::emote_url("25", "default", "dark", "3.0")
+}
@@ -0,0 +1,39 @@
+terraform {
+  required_providers {
+    twitch = {
+      source = "ellg/twitch"
+    }
+  }
+}
+
+variable client_id {
+  type = string
+  sensitive = true
+}
+
+variable access_token {
+  type = string
+  sensitive = true
+}
+
+provider "twitch" {
+  client_id    = var.client_id
+  access_token = var.access_token
+}
+
+resource "twitch_banned_user" "spammer" {
+  broadcaster_id = "12345678"
+  user_id        = "87654321"
+  reason         = "spam"
+}
+
+resource "twitch_banned_user" "cooldown" {
+  broadcaster_id = "12345678"
+  user_id        = "11223344"
+  reason         = "take a break"
+  duration       = 600
+}
+
+data "twitch_banned_users" "ellg" {
+  broadcaster_id = "12345678"
+}
@@ -0,0 +1,31 @@
+terraform {
+  required_providers {
+    twitch = {
+      source = "ellg/twitch"
+    }
+  }
+}
+
+variable client_id {
+  type = string
+  sensitive = true
+}
+
+variable access_token {
+  type = string
+  sensitive = true
+}
+
+provider "twitch" {
+  client_id    = var.client_id
+  access_token = var.access_token
+}
+
+resource "twitch_blocked_terms" "ellg" {
+  broadcaster_id = "12345678"
+
+  terms = [
+    "spoilers",
+    "buy followers",
+  ]
+}
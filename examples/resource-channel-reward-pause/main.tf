@@ -0,0 +1,53 @@
+terraform {
+  required_providers {
+    twitch = {
+      source = "ellg/twitch"
+    }
+  }
+}
+
+variable client_id {
+  type = string
+  sensitive = true
+}
+
+variable access_token {
+  type = string
+  sensitive = true
+}
+
+provider "twitch" {
+  client_id    = var.client_id
+  access_token = var.access_token
+}
+
+variable "raid_mode" {
+  type    = bool
+  default = false
+}
+
+resource "twitch_channel_reward" "hydrate" {
+  broadcaster_id = "12345678"
+  title          = "Hydrate"
+  cost           = 100
+  is_enabled     = true
+}
+
+resource "twitch_channel_reward" "song_request" {
+  broadcaster_id = "12345678"
+  title          = "Song request"
+  cost           = 500
+  prompt         = "Link a song"
+  is_enabled     = true
+}
+
+# Freeze redemptions while raid mode is on.
+resource "twitch_channel_reward_pause" "raid_mode" {
+  count = var.raid_mode ? 1 : 0
+
+  broadcaster_id = "12345678"
+  reward_ids = [
+    twitch_channel_reward.hydrate.id,
+    twitch_channel_reward.song_request.id,
+  ]
+}
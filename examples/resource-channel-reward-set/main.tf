@@ -0,0 +1,46 @@
+terraform {
+  required_providers {
+    twitch = {
+      source = "ellg/twitch"
+    }
+  }
+}
+
+variable client_id {
+  type = string
+  sensitive = true
+}
+
+variable access_token {
+  type = string
+  sensitive = true
+}
+
+provider "twitch" {
+  client_id    = var.client_id
+  access_token = var.access_token
+}
+
+resource "twitch_channel_reward_set" "inline" {
+  broadcaster_id = "12345678"
+
+  rewards = {
+    hydrate = {
+      title = "Hydrate"
+      cost  = 100
+    }
+    posture = {
+      title                      = "Posture check"
+      cost                       = 250
+      prompt                     = "Remind the streamer to sit up straight"
+      background_color           = "#9146FF"
+      is_global_cooldown_enabled = true
+      global_cooldown_seconds    = 300
+    }
+  }
+}
+
+resource "twitch_channel_reward_set" "seasonal" {
+  broadcaster_id = "87654321"
+  catalog_file   = "${path.module}/rewards.yaml"
+}
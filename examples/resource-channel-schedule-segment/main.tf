@@ -0,0 +1,36 @@
+terraform {
+  required_providers {
+    twitch = {
+      source = "ellg/twitch"
+    }
+  }
+}
+
+variable client_id {
+  type = string
+  sensitive = true
+}
+
+variable access_token {
+  type = string
+  sensitive = true
+}
+
+provider "twitch" {
+  client_id    = var.client_id
+  access_token = var.access_token
+}
+
+data "twitch_game" "programming" {
+  name = "Software and Game Development"
+}
+
+resource "twitch_channel_schedule_segment" "tuesday" {
+  broadcaster_id = "12345678"
+  start_time     = "2024-07-02T19:00:00-04:00"
+  timezone       = "America/New_York"
+  duration       = 180
+  is_recurring   = true
+  category_id    = data.twitch_game.programming.id
+  title          = "building a terraform provider"
+}
@@ -0,0 +1,40 @@
+terraform {
+  required_providers {
+    twitch = {
+      source = "ellg/twitch"
+    }
+  }
+}
+
+variable client_id {
+  type = string
+  sensitive = true
+}
+
+variable access_token {
+  type = string
+  sensitive = true
+}
+
+provider "twitch" {
+  client_id    = var.client_id
+  access_token = var.access_token
+}
+
+resource "twitch_channel_schedule_settings" "ellg" {
+  broadcaster_id      = "12345678"
+  is_vacation_enabled = true
+  vacation_start_time = "2024-08-01T00:00:00-04:00"
+  vacation_end_time   = "2024-08-15T00:00:00-04:00"
+  timezone            = "America/New_York"
+}
+
+data "twitch_channel_schedule" "ellg" {
+  broadcaster_id    = "12345678"
+  limit             = 10
+  include_icalendar = true
+}
+
+output "upcoming_segments" {
+  value = data.twitch_channel_schedule.ellg.segments
+}
@@ -0,0 +1,51 @@
+terraform {
+  required_providers {
+    twitch = {
+      source = "ellg/twitch"
+    }
+  }
+}
+
+variable client_id {
+  type = string
+  sensitive = true
+}
+
+variable access_token {
+  type = string
+  sensitive = true
+}
+
+provider "twitch" {
+  client_id    = var.client_id
+  access_token = var.access_token
+}
+
+variable webhook_secret {
+  type = string
+  sensitive = true
+}
+
+resource "twitch_eventsub_conduit" "bots" {
+  shard_count = 2
+}
+
+resource "twitch_eventsub_conduit_shard" "bots" {
+  count = 2
+
+  conduit_id       = twitch_eventsub_conduit.bots.id
+  shard_id         = tostring(count.index)
+  webhook_callback = "https://bot-${count.index}.example.com/eventsub"
+  webhook_secret   = var.webhook_secret
+}
+
+resource "twitch_eventsub_subscription" "chat" {
+  type    = "channel.chat.message"
+  version = "1"
+  condition = {
+    broadcaster_user_id = "12345678"
+    user_id             = "87654321"
+  }
+
+  conduit_id = twitch_eventsub_conduit.bots.id
+}
@@ -0,0 +1,42 @@
+terraform {
+  required_providers {
+    twitch = {
+      source = "ellg/twitch"
+    }
+  }
+}
+
+variable client_id {
+  type = string
+  sensitive = true
+}
+
+variable access_token {
+  type = string
+  sensitive = true
+}
+
+provider "twitch" {
+  client_id    = var.client_id
+  access_token = var.access_token
+}
+
+variable webhook_secret {
+  type = string
+  sensitive = true
+}
+
+resource "twitch_eventsub_subscription" "follows" {
+  type    = "channel.follow"
+  version = "2"
+  condition = {
+    broadcaster_user_id = "12345678"
+    moderator_user_id   = "12345678"
+  }
+
+  webhook_callback = "https://alerts.example.com/eventsub"
+  webhook_secret   = var.webhook_secret
+
+  wait_for_enabled = true
+  wait_timeout     = "1m"
+}
@@ -0,0 +1,67 @@
+package helix
+
+import (
+	"net/url"
+)
+
+type ChannelEditor struct {
+	UserID    string `json:"user_id"`
+	UserName  string `json:"user_name"`
+	CreatedAt string `json:"created_at"`
+}
+
+type GetChannelEditorsResponse struct {
+	Data []ChannelEditor `json:"data"`
+}
+
+func (c *Client) GetChannelEditors(broadcasterID string) ([]ChannelEditor, error) {
+	query := url.Values{}
+	query.Set("broadcaster_id", broadcasterID)
+
+	var editorsResponse GetChannelEditorsResponse
+	err := c.doRequest("GET", "/channels/editors", query, nil, &editorsResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return editorsResponse.Data, nil
+}
+
+type ChannelFollower struct {
+	UserID     string `json:"user_id"`
+	UserLogin  string `json:"user_login"`
+	UserName   string `json:"user_name"`
+	FollowedAt string `json:"followed_at"`
+}
+
+type GetChannelFollowersResponse struct {
+	Data       []ChannelFollower `json:"data"`
+	Total      int               `json:"total"`
+	Pagination Pagination        `json:"pagination"`
+}
+
+// GetChannelFollowers returns the follower total of a channel and up to limit
+// followers, most recent first. With a limit of 0 only the total is fetched.
+// Listing followers requires the token owner to be a moderator of the channel.
+func (c *Client) GetChannelFollowers(broadcasterID string, limit int) (int, []ChannelFollower, error) {
+	query := url.Values{}
+	query.Set("broadcaster_id", broadcasterID)
+
+	if limit > 0 {
+		followers, total, err := getPagesWithTotal[ChannelFollower](c, "/channels/followers", query, limit)
+
+		return total, followers, err
+	}
+
+	// Helix cannot be asked for zero followers, so the total comes from the
+	// smallest page it allows.
+	query.Set("first", "1")
+
+	var followersResponse GetChannelFollowersResponse
+	err := c.doRequest("GET", "/channels/followers", query, nil, &followersResponse)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return followersResponse.Total, []ChannelFollower{}, nil
+}
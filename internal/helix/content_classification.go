@@ -0,0 +1,43 @@
+package helix
+
+import (
+	"net/url"
+)
+
+// ContentClassificationLabelMatureGame is applied by Twitch based on the
+// game's rating and cannot be set through the API.
+const ContentClassificationLabelMatureGame = "MatureGame"
+
+type ContentClassificationLabel struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// ContentClassificationLabelSetting enables or disables a label in
+// UpdateChannelRequest.
+type ContentClassificationLabelSetting struct {
+	ID        string `json:"id"`
+	IsEnabled bool   `json:"is_enabled"`
+}
+
+type GetContentClassificationLabelsResponse struct {
+	Data []ContentClassificationLabel `json:"data"`
+}
+
+// GetContentClassificationLabels returns every content classification label,
+// with names and descriptions in the given locale, such as en-US.
+func (c *Client) GetContentClassificationLabels(locale string) ([]ContentClassificationLabel, error) {
+	query := url.Values{}
+	if locale != "" {
+		query.Set("locale", locale)
+	}
+
+	var labelsResponse GetContentClassificationLabelsResponse
+	err := c.doRequest("GET", "/content_classification_labels", query, nil, &labelsResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return labelsResponse.Data, nil
+}
@@ -0,0 +1,276 @@
+package helix
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// EventSub subscription statuses. Any status other than enabled or pending
+// verification means Twitch has stopped delivering events.
+const (
+	EventSubStatusEnabled                            = "enabled"
+	EventSubStatusWebhookCallbackVerificationPending = "webhook_callback_verification_pending"
+	EventSubStatusWebhookCallbackVerificationFailed  = "webhook_callback_verification_failed"
+)
+
+// EventSubTransport describes where Twitch delivers events: a webhook
+// callback, a websocket session or a conduit.
+type EventSubTransport struct {
+	Method         string `json:"method"`
+	Callback       string `json:"callback,omitempty"`
+	Secret         string `json:"secret,omitempty"`
+	SessionID      string `json:"session_id,omitempty"`
+	ConduitID      string `json:"conduit_id,omitempty"`
+	ConnectedAt    string `json:"connected_at,omitempty"`
+	DisconnectedAt string `json:"disconnected_at,omitempty"`
+}
+
+type EventSubSubscription struct {
+	ID        string            `json:"id"`
+	Status    string            `json:"status"`
+	Type      string            `json:"type"`
+	Version   string            `json:"version"`
+	Condition map[string]string `json:"condition"`
+	CreatedAt string            `json:"created_at"`
+	Transport EventSubTransport `json:"transport"`
+	Cost      int               `json:"cost"`
+}
+
+type GetEventSubSubscriptionsResponse struct {
+	Data         []EventSubSubscription `json:"data"`
+	Total        int                    `json:"total"`
+	TotalCost    int                    `json:"total_cost"`
+	MaxTotalCost int                    `json:"max_total_cost"`
+	Pagination   Pagination             `json:"pagination"`
+}
+
+type CreateEventSubSubscriptionRequest struct {
+	Type      string            `json:"type"`
+	Version   string            `json:"version"`
+	Condition map[string]string `json:"condition"`
+	Transport EventSubTransport `json:"transport"`
+}
+
+func (c *Client) CreateEventSubSubscription(createRequest *CreateEventSubSubscriptionRequest) (*EventSubSubscription, error) {
+	var subscriptionResponse GetEventSubSubscriptionsResponse
+	err := c.doRequest("POST", "/eventsub/subscriptions", nil, createRequest, &subscriptionResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(subscriptionResponse.Data) == 0 {
+		return nil, fmt.Errorf("no subscription returned for %s", createRequest.Type)
+	}
+
+	return &subscriptionResponse.Data[0], nil
+}
+
+// GetEventSubSubscription returns the subscription with the given ID, or nil
+// if it no longer exists.
+func (c *Client) GetEventSubSubscription(subscriptionID string) (*EventSubSubscription, error) {
+	query := url.Values{}
+	query.Set("subscription_id", subscriptionID)
+
+	var subscriptionResponse GetEventSubSubscriptionsResponse
+	err := c.doRequest("GET", "/eventsub/subscriptions", query, nil, &subscriptionResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, subscription := range subscriptionResponse.Data {
+		if subscription.ID == subscriptionID {
+			return &subscription, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (c *Client) DeleteEventSubSubscription(subscriptionID string) error {
+	query := url.Values{}
+	query.Set("id", subscriptionID)
+
+	return c.doRequest("DELETE", "/eventsub/subscriptions", query, nil, nil)
+}
+
+type Conduit struct {
+	ID         string `json:"id"`
+	ShardCount int    `json:"shard_count"`
+}
+
+type GetConduitsResponse struct {
+	Data []Conduit `json:"data"`
+}
+
+func (c *Client) GetConduits() ([]Conduit, error) {
+	var conduitsResponse GetConduitsResponse
+	err := c.doRequest("GET", "/eventsub/conduits", nil, nil, &conduitsResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return conduitsResponse.Data, nil
+}
+
+func (c *Client) GetConduitByID(conduitID string) (*Conduit, error) {
+	conduits, err := c.GetConduits()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, conduit := range conduits {
+		if conduit.ID == conduitID {
+			return &conduit, nil
+		}
+	}
+
+	return nil, nil
+}
+
+type conduitRequest struct {
+	ID         string `json:"id,omitempty"`
+	ShardCount int    `json:"shard_count"`
+}
+
+func (c *Client) CreateConduit(shardCount int) (*Conduit, error) {
+	var conduitsResponse GetConduitsResponse
+	err := c.doRequest("POST", "/eventsub/conduits", nil, &conduitRequest{ShardCount: shardCount}, &conduitsResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(conduitsResponse.Data) == 0 {
+		return nil, fmt.Errorf("no conduit returned")
+	}
+
+	return &conduitsResponse.Data[0], nil
+}
+
+func (c *Client) UpdateConduit(conduitID string, shardCount int) (*Conduit, error) {
+	var conduitsResponse GetConduitsResponse
+	err := c.doRequest("PATCH", "/eventsub/conduits", nil, &conduitRequest{ID: conduitID, ShardCount: shardCount}, &conduitsResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(conduitsResponse.Data) == 0 {
+		return nil, fmt.Errorf("no conduit returned for %s", conduitID)
+	}
+
+	return &conduitsResponse.Data[0], nil
+}
+
+func (c *Client) DeleteConduit(conduitID string) error {
+	query := url.Values{}
+	query.Set("id", conduitID)
+
+	return c.doRequest("DELETE", "/eventsub/conduits", query, nil, nil)
+}
+
+type ConduitShard struct {
+	ID        string            `json:"id"`
+	Status    string            `json:"status,omitempty"`
+	Transport EventSubTransport `json:"transport"`
+}
+
+// GetConduitShardByID returns the shard with the given ID, or nil if the
+// conduit does not have it.
+func (c *Client) GetConduitShardByID(conduitID, shardID string) (*ConduitShard, error) {
+	query := url.Values{}
+	query.Set("conduit_id", conduitID)
+
+	shards, err := getAllPages[ConduitShard](c, "/eventsub/conduits/shards", query)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, shard := range shards {
+		if shard.ID == shardID {
+			return &shard, nil
+		}
+	}
+
+	return nil, nil
+}
+
+type UpdateConduitShardsRequest struct {
+	ConduitID string         `json:"conduit_id"`
+	Shards    []ConduitShard `json:"shards"`
+}
+
+type UpdateConduitShardsResponse struct {
+	Data   []ConduitShard `json:"data"`
+	Errors []struct {
+		ID      string `json:"id"`
+		Message string `json:"message"`
+		Code    string `json:"code"`
+	} `json:"errors"`
+}
+
+// UpdateConduitShards assigns transports to shards of a conduit. Twitch reports
+// per-shard failures in the response body rather than the status code, so
+// those are returned as an error.
+func (c *Client) UpdateConduitShards(updateRequest *UpdateConduitShardsRequest) ([]ConduitShard, error) {
+	var shardsResponse UpdateConduitShardsResponse
+	err := c.doRequest("PATCH", "/eventsub/conduits/shards", nil, updateRequest, &shardsResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(shardsResponse.Errors) > 0 {
+		shardError := shardsResponse.Errors[0]
+
+		return nil, fmt.Errorf("shard %s: %s (%s)", shardError.ID, shardError.Message, shardError.Code)
+	}
+
+	return shardsResponse.Data, nil
+}
+
+// EventSubSubscriptionsFilter narrows GetEventSubSubscriptions. Twitch accepts
+// at most one filter per request.
+type EventSubSubscriptionsFilter struct {
+	Status string
+	Type   string
+	UserID string
+}
+
+// GetEventSubSubscriptions lists every subscription matching filter across all
+// pages. The totals are reported for the client ID as a whole.
+func (c *Client) GetEventSubSubscriptions(filter EventSubSubscriptionsFilter) (*GetEventSubSubscriptionsResponse, error) {
+	query := url.Values{}
+	if filter.Status != "" {
+		query.Set("status", filter.Status)
+	}
+
+	if filter.Type != "" {
+		query.Set("type", filter.Type)
+	}
+
+	if filter.UserID != "" {
+		query.Set("user_id", filter.UserID)
+	}
+
+	var subscriptions *GetEventSubSubscriptionsResponse
+
+	for {
+		var page GetEventSubSubscriptionsResponse
+		err := c.doRequest("GET", "/eventsub/subscriptions", query, nil, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		if subscriptions == nil {
+			subscriptions = &page
+		} else {
+			subscriptions.Data = append(subscriptions.Data, page.Data...)
+		}
+
+		if page.Pagination.Cursor == "" || len(page.Data) == 0 {
+			subscriptions.Pagination = Pagination{}
+
+			return subscriptions, nil
+		}
+
+		query.Set("after", page.Pagination.Cursor)
+	}
+}
@@ -0,0 +1,25 @@
+package helix
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type Game struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	BoxArtURL string `json:"box_art_url"`
+	IGDBID    string `json:"igdb_id"`
+}
+
+// GetTopGames returns up to limit games, ordered by current viewer count.
+func (c *Client) GetTopGames(limit int) ([]Game, error) {
+	return getPages[Game](c, "/games/top", url.Values{}, limit)
+}
+
+// RenderBoxArtURL fills the {width} and {height} placeholders of a box art
+// URL template.
+func RenderBoxArtURL(template string, width, height int) string {
+	return strings.NewReplacer("{width}", strconv.Itoa(width), "{height}", strconv.Itoa(height)).Replace(template)
+}
@@ -3,12 +3,16 @@ package helix
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
-	"strings"
+	"net/url"
+	"strconv"
 )
 
+const helixBaseURL = "https://api.twitch.tv/helix"
+
 type Client struct {
 	ClientID    string
 	AccessToken string
@@ -21,6 +25,138 @@ func NewHelixClient(clientID, accessToken string) *Client {
 	}
 }
 
+// APIError is returned when helix responds with a non-2xx status code.
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("unexpected status code: %d, %s", e.StatusCode, e.Body)
+}
+
+// IsNotFound reports whether err is a helix 404 response.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// doRequest sends a request to the helix endpoint with the given query, encoding
+// requestBody as JSON when set and decoding the response into responseBody when set.
+// A *[]byte responseBody receives the raw response instead.
+func (c *Client) doRequest(method, endpoint string, query url.Values, requestBody, responseBody any) error {
+	requestURL := helixBaseURL + endpoint
+	if len(query) > 0 {
+		requestURL += "?" + query.Encode()
+	}
+
+	var reader io.Reader
+	if requestBody != nil {
+		body, err := json.Marshal(requestBody)
+		if err != nil {
+			return err
+		}
+
+		reader = bytes.NewBuffer(body)
+	}
+
+	req, err := http.NewRequest(method, requestURL, reader)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Client-ID", c.ClientID)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.AccessToken))
+	if requestBody != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	client := &http.Client{}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode >= 300 {
+		return &APIError{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	if responseBody == nil || len(body) == 0 {
+		return nil
+	}
+
+	if raw, ok := responseBody.(*[]byte); ok {
+		*raw = body
+
+		return nil
+	}
+
+	return json.Unmarshal(body, responseBody)
+}
+
+type Pagination struct {
+	Cursor string `json:"cursor"`
+}
+
+// getAllPages reads every page of a helix list endpoint by following the
+// pagination cursor.
+func getAllPages[T any](c *Client, endpoint string, query url.Values) ([]T, error) {
+	return getPages[T](c, endpoint, query, 0)
+}
+
+// getPages reads pages of a helix list endpoint until it has limit items, or
+// every page when limit is 0. Pages are requested at the size the endpoint
+// allows, at most 100.
+func getPages[T any](c *Client, endpoint string, query url.Values, limit int) ([]T, error) {
+	items, _, err := getPagesWithTotal[T](c, endpoint, query, limit)
+
+	return items, err
+}
+
+// getPagesWithTotal is getPages for endpoints that also report the total
+// number of items, which is returned from the last page read.
+func getPagesWithTotal[T any](c *Client, endpoint string, query url.Values, limit int) ([]T, int, error) {
+	items := []T{}
+
+	if limit > 0 {
+		query.Set("first", strconv.Itoa(min(limit, 100)))
+	}
+
+	for {
+		var page struct {
+			Data       []T        `json:"data"`
+			Total      int        `json:"total"`
+			Pagination Pagination `json:"pagination"`
+		}
+
+		err := c.doRequest("GET", endpoint, query, nil, &page)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		items = append(items, page.Data...)
+
+		if limit > 0 && len(items) >= limit {
+			return items[:limit], page.Total, nil
+		}
+
+		if page.Pagination.Cursor == "" || len(page.Data) == 0 {
+			return items, page.Total, nil
+		}
+
+		query.Set("after", page.Pagination.Cursor)
+	}
+}
+
 type GetChannelResponse struct {
 	Data []struct {
 		BroadcasterId         string   `json:"broadcaster_id"`
@@ -77,15 +213,12 @@ func (c *Client) GetChannel(broadcasterID string) (*GetChannelResponse, error) {
 }
 
 type UpdateChannelRequest struct {
-	GameID                      string   `json:"game_id,omitempty"`
-	BroadcasterLanguage         string   `json:"broadcaster_language,omitempty"`
-	Title                       string   `json:"title,omitempty"`
-	Delay                       string   `json:"delay,omitempty"`
-	Tags                        []string `json:"tags,omitempty"`
-	ContentClassificationLabels []struct {
-		ID        string `json:"id"`
-		IsEnabled bool   `json:"is_enabled"`
-	} `json:"content_classification_labels,omitempty"`
+	GameID                      string                              `json:"game_id,omitempty"`
+	BroadcasterLanguage         string                              `json:"broadcaster_language,omitempty"`
+	Title                       string                              `json:"title,omitempty"`
+	Delay                       string                              `json:"delay,omitempty"`
+	Tags                        []string                            `json:"tags,omitempty"`
+	ContentClassificationLabels []ContentClassificationLabelSetting `json:"content_classification_labels,omitempty"`
 }
 
 func (c *Client) UpdateChannel(broadcasterId string, updateRequest UpdateChannelRequest) error {
@@ -139,7 +272,7 @@ type GetGameResponse struct {
 }
 
 func (c *Client) GetGameByName(gameName string) (*GetGameResponse, error) {
-	gameName = strings.Join(strings.Split(gameName, " "), "+")
+	gameName = url.QueryEscape(gameName)
 	url := fmt.Sprintf("https://api.twitch.tv/helix/games?name=%s", gameName)
 
 	token := fmt.Sprintf("Bearer %s", c.AccessToken)
@@ -221,18 +354,25 @@ func (c *Client) GetGameById(gameId string) (*GetGameResponse, error) {
 	return &gameResponse, nil
 }
 
+// RewardImage holds the URLs of a reward image at each scale.
+type RewardImage struct {
+	URL1x string `json:"url_1x"`
+	URL2x string `json:"url_2x"`
+	URL4x string `json:"url_4x"`
+}
+
 type ChannelReward struct {
-	BroadcasterName     string `json:"broadcaster_name"`
-	BroadcasterLogin    string `json:"broadcaster_login"`
-	BroadcasterID       string `json:"broadcaster_id"`
-	ID                  string `json:"id"`
-	Image               string `json:"image"`
-	BackgroundColor     string `json:"background_color"`
-	IsEnabled           bool   `json:"is_enabled"`
-	Cost                int    `json:"cost"`
-	Title               string `json:"title"`
-	Prompt              string `json:"prompt"`
-	IsUserInputRequired bool   `json:"is_user_input_required"`
+	BroadcasterName     string       `json:"broadcaster_name"`
+	BroadcasterLogin    string       `json:"broadcaster_login"`
+	BroadcasterID       string       `json:"broadcaster_id"`
+	ID                  string       `json:"id"`
+	Image               *RewardImage `json:"image"`
+	BackgroundColor     string       `json:"background_color"`
+	IsEnabled           bool         `json:"is_enabled"`
+	Cost                int          `json:"cost"`
+	Title               string       `json:"title"`
+	Prompt              string       `json:"prompt"`
+	IsUserInputRequired bool         `json:"is_user_input_required"`
 	MaxPerStreamSetting struct {
 		IsEnabled    bool `json:"is_enabled"`
 		MaxPerStream int  `json:"max_per_stream"`
@@ -245,16 +385,12 @@ type ChannelReward struct {
 		IsEnabled             bool `json:"is_enabled"`
 		GlobalCooldownSeconds int  `json:"global_cooldown_seconds"`
 	} `json:"global_cooldown_setting"`
-	IsPaused     bool `json:"is_paused"`
-	IsInStock    bool `json:"is_in_stock"`
-	DefaultImage struct {
-		URL1x string `json:"url_1x"`
-		URL2x string `json:"url_2x"`
-		URL4x string `json:"url_4x"`
-	} `json:"default_image"`
-	ShouldRedemptionsSkipRequestQueue bool   `json:"should_redemptions_skip_request_queue"`
-	RedemptionsRedeemedCurrentStream  string `json:"redemptions_redeemed_current_stream"`
-	CooldownExpiresAt                 string `json:"cooldown_expires_at"`
+	IsPaused                          bool        `json:"is_paused"`
+	IsInStock                         bool        `json:"is_in_stock"`
+	DefaultImage                      RewardImage `json:"default_image"`
+	ShouldRedemptionsSkipRequestQueue bool        `json:"should_redemptions_skip_request_queue"`
+	RedemptionsRedeemedCurrentStream  *int        `json:"redemptions_redeemed_current_stream"`
+	CooldownExpiresAt                 string      `json:"cooldown_expires_at"`
 }
 
 type GetChannelRewardsResponse struct {
@@ -392,9 +528,10 @@ type UpdateChannelRewardRequest struct {
 	Prompt                  string `json:"prompt,omitempty"`
 	Cost                    int    `json:"cost"`
 	BackgroundColor         string `json:"background_color,omitempty"`
-	IsGlobalCooldownEnabled bool   `json:"is_global_cooldown_enabled,omitempty"`
+	IsGlobalCooldownEnabled bool   `json:"is_global_cooldown_enabled"`
 	GlobalCooldownSeconds   int    `json:"global_cooldown_seconds,omitempty"`
 	IsEnabled               bool   `json:"is_enabled"`
+	IsPaused                *bool  `json:"is_paused,omitempty"`
 }
 
 func (c *Client) UpdateChannelReward(broadcasterID, rewardID string, updateRequest *UpdateChannelRewardRequest) (*ChannelReward, error) {
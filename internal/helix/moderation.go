@@ -0,0 +1,223 @@
+package helix
+
+import (
+	"fmt"
+	"net/url"
+)
+
+type AutoModSettings struct {
+	BroadcasterID           string `json:"broadcaster_id"`
+	ModeratorID             string `json:"moderator_id"`
+	OverallLevel            *int   `json:"overall_level"`
+	Disability              int    `json:"disability"`
+	Aggression              int    `json:"aggression"`
+	SexualitySexOrGender    int    `json:"sexuality_sex_or_gender"`
+	Misogyny                int    `json:"misogyny"`
+	Bullying                int    `json:"bullying"`
+	Swearing                int    `json:"swearing"`
+	RaceEthnicityOrReligion int    `json:"race_ethnicity_or_religion"`
+	SexBasedTerms           int    `json:"sex_based_terms"`
+}
+
+type GetAutoModSettingsResponse struct {
+	Data []AutoModSettings `json:"data"`
+}
+
+func (c *Client) GetAutoModSettings(broadcasterID, moderatorID string) (*AutoModSettings, error) {
+	query := url.Values{}
+	query.Set("broadcaster_id", broadcasterID)
+	query.Set("moderator_id", moderatorID)
+
+	var settingsResponse GetAutoModSettingsResponse
+	err := c.doRequest("GET", "/moderation/automod/settings", query, nil, &settingsResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(settingsResponse.Data) == 0 {
+		return nil, fmt.Errorf("no automod settings returned for broadcaster %s", broadcasterID)
+	}
+
+	return &settingsResponse.Data[0], nil
+}
+
+// UpdateAutoModSettingsRequest sets either OverallLevel or the individual
+// category levels; helix rejects requests that set both. Because the update
+// overwrites the current settings, unset categories are reset to 0.
+type UpdateAutoModSettingsRequest struct {
+	OverallLevel            *int `json:"overall_level,omitempty"`
+	Disability              *int `json:"disability,omitempty"`
+	Aggression              *int `json:"aggression,omitempty"`
+	SexualitySexOrGender    *int `json:"sexuality_sex_or_gender,omitempty"`
+	Misogyny                *int `json:"misogyny,omitempty"`
+	Bullying                *int `json:"bullying,omitempty"`
+	Swearing                *int `json:"swearing,omitempty"`
+	RaceEthnicityOrReligion *int `json:"race_ethnicity_or_religion,omitempty"`
+	SexBasedTerms           *int `json:"sex_based_terms,omitempty"`
+}
+
+func (c *Client) UpdateAutoModSettings(broadcasterID, moderatorID string, updateRequest *UpdateAutoModSettingsRequest) (*AutoModSettings, error) {
+	query := url.Values{}
+	query.Set("broadcaster_id", broadcasterID)
+	query.Set("moderator_id", moderatorID)
+
+	var settingsResponse GetAutoModSettingsResponse
+	err := c.doRequest("PUT", "/moderation/automod/settings", query, updateRequest, &settingsResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(settingsResponse.Data) == 0 {
+		return nil, fmt.Errorf("no automod settings returned for broadcaster %s", broadcasterID)
+	}
+
+	return &settingsResponse.Data[0], nil
+}
+
+type BlockedTerm struct {
+	BroadcasterID string `json:"broadcaster_id"`
+	ModeratorID   string `json:"moderator_id"`
+	ID            string `json:"id"`
+	Text          string `json:"text"`
+	CreatedAt     string `json:"created_at"`
+	UpdatedAt     string `json:"updated_at"`
+	ExpiresAt     string `json:"expires_at"`
+}
+
+type GetBlockedTermsResponse struct {
+	Data       []BlockedTerm `json:"data"`
+	Pagination Pagination    `json:"pagination"`
+}
+
+func (c *Client) GetBlockedTerms(broadcasterID, moderatorID string) ([]BlockedTerm, error) {
+	query := url.Values{}
+	query.Set("broadcaster_id", broadcasterID)
+	query.Set("moderator_id", moderatorID)
+	query.Set("first", "100")
+
+	return getAllPages[BlockedTerm](c, "/moderation/blocked_terms", query)
+}
+
+func (c *Client) GetBlockedTermByID(broadcasterID, moderatorID, termID string) (*BlockedTerm, error) {
+	terms, err := c.GetBlockedTerms(broadcasterID, moderatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, term := range terms {
+		if term.ID == termID {
+			return &term, nil
+		}
+	}
+
+	return nil, nil
+}
+
+type AddBlockedTermRequest struct {
+	Text string `json:"text"`
+}
+
+func (c *Client) AddBlockedTerm(broadcasterID, moderatorID, text string) (*BlockedTerm, error) {
+	query := url.Values{}
+	query.Set("broadcaster_id", broadcasterID)
+	query.Set("moderator_id", moderatorID)
+
+	var termsResponse GetBlockedTermsResponse
+	err := c.doRequest("POST", "/moderation/blocked_terms", query, &AddBlockedTermRequest{Text: text}, &termsResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(termsResponse.Data) == 0 {
+		return nil, fmt.Errorf("no blocked term returned for %q", text)
+	}
+
+	return &termsResponse.Data[0], nil
+}
+
+func (c *Client) RemoveBlockedTerm(broadcasterID, moderatorID, termID string) error {
+	query := url.Values{}
+	query.Set("broadcaster_id", broadcasterID)
+	query.Set("moderator_id", moderatorID)
+	query.Set("id", termID)
+
+	return c.doRequest("DELETE", "/moderation/blocked_terms", query, nil, nil)
+}
+
+type BanUserRequest struct {
+	UserID   string `json:"user_id"`
+	Duration int    `json:"duration,omitempty"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+type Ban struct {
+	BroadcasterID string `json:"broadcaster_id"`
+	ModeratorID   string `json:"moderator_id"`
+	UserID        string `json:"user_id"`
+	CreatedAt     string `json:"created_at"`
+	EndTime       string `json:"end_time"`
+}
+
+type BanUserResponse struct {
+	Data []Ban `json:"data"`
+}
+
+// BanUser bans a user from the broadcaster's chat, or times them out when
+// Duration is set.
+func (c *Client) BanUser(broadcasterID, moderatorID string, banRequest *BanUserRequest) (*Ban, error) {
+	query := url.Values{}
+	query.Set("broadcaster_id", broadcasterID)
+	query.Set("moderator_id", moderatorID)
+
+	body := struct {
+		Data *BanUserRequest `json:"data"`
+	}{
+		Data: banRequest,
+	}
+
+	var banResponse BanUserResponse
+	err := c.doRequest("POST", "/moderation/bans", query, &body, &banResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(banResponse.Data) == 0 {
+		return nil, fmt.Errorf("no ban returned for user %s", banRequest.UserID)
+	}
+
+	return &banResponse.Data[0], nil
+}
+
+func (c *Client) UnbanUser(broadcasterID, moderatorID, userID string) error {
+	query := url.Values{}
+	query.Set("broadcaster_id", broadcasterID)
+	query.Set("moderator_id", moderatorID)
+	query.Set("user_id", userID)
+
+	return c.doRequest("DELETE", "/moderation/bans", query, nil, nil)
+}
+
+type BannedUser struct {
+	UserID         string `json:"user_id"`
+	UserLogin      string `json:"user_login"`
+	UserName       string `json:"user_name"`
+	ExpiresAt      string `json:"expires_at"`
+	CreatedAt      string `json:"created_at"`
+	Reason         string `json:"reason"`
+	ModeratorID    string `json:"moderator_id"`
+	ModeratorLogin string `json:"moderator_login"`
+	ModeratorName  string `json:"moderator_name"`
+}
+
+// GetBannedUsers lists the users banned or timed out in the broadcaster's
+// chat, optionally filtered to at most 100 user IDs.
+func (c *Client) GetBannedUsers(broadcasterID string, userIDs []string) ([]BannedUser, error) {
+	query := url.Values{}
+	query.Set("broadcaster_id", broadcasterID)
+	query.Set("first", "100")
+	for _, userID := range userIDs {
+		query.Add("user_id", userID)
+	}
+
+	return getAllPages[BannedUser](c, "/moderation/banned", query)
+}
@@ -0,0 +1,103 @@
+package helix
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// GetManageableChannelRewards returns the custom rewards of a broadcaster that
+// were created by this client ID, the only ones it may update or delete.
+func (c *Client) GetManageableChannelRewards(broadcasterID string) ([]ChannelReward, error) {
+	query := url.Values{}
+	query.Set("broadcaster_id", broadcasterID)
+	query.Set("only_manageable_rewards", "true")
+
+	var rewardsResponse GetChannelRewardsResponse
+	err := c.doRequest("GET", "/channel_points/custom_rewards", query, nil, &rewardsResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return rewardsResponse.Data, nil
+}
+
+// SetChannelRewardPaused pauses or resumes redemptions of a reward without
+// changing any of its other settings.
+func (c *Client) SetChannelRewardPaused(broadcasterID, rewardID string, paused bool) (*ChannelReward, error) {
+	query := url.Values{}
+	query.Set("broadcaster_id", broadcasterID)
+	query.Set("id", rewardID)
+
+	var rewardsResponse GetChannelRewardsResponse
+	err := c.doRequest("PATCH", "/channel_points/custom_rewards", query, map[string]bool{"is_paused": paused}, &rewardsResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rewardsResponse.Data) == 0 {
+		return nil, fmt.Errorf("no reward returned for %s", rewardID)
+	}
+
+	return &rewardsResponse.Data[0], nil
+}
+
+// Custom reward redemption statuses.
+const (
+	RedemptionStatusUnfulfilled = "UNFULFILLED"
+	RedemptionStatusFulfilled   = "FULFILLED"
+	RedemptionStatusCanceled    = "CANCELED"
+)
+
+// maxRedemptionsPerUpdate is the number of redemption IDs Update Redemption
+// Status accepts at once.
+const maxRedemptionsPerUpdate = 50
+
+type Redemption struct {
+	ID         string `json:"id"`
+	UserID     string `json:"user_id"`
+	UserLogin  string `json:"user_login"`
+	UserName   string `json:"user_name"`
+	UserInput  string `json:"user_input"`
+	Status     string `json:"status"`
+	RedeemedAt string `json:"redeemed_at"`
+	Reward     struct {
+		ID     string `json:"id"`
+		Title  string `json:"title"`
+		Prompt string `json:"prompt"`
+		Cost   int    `json:"cost"`
+	} `json:"reward"`
+}
+
+// GetRedemptions lists every redemption of a reward with the given status,
+// oldest first.
+func (c *Client) GetRedemptions(broadcasterID, rewardID, status string) ([]Redemption, error) {
+	query := url.Values{}
+	query.Set("broadcaster_id", broadcasterID)
+	query.Set("reward_id", rewardID)
+	query.Set("status", status)
+	query.Set("first", "50")
+
+	return getAllPages[Redemption](c, "/channel_points/custom_rewards/redemptions", query)
+}
+
+// UpdateRedemptionStatus marks redemptions of a reward as fulfilled or
+// canceled. Canceling refunds the viewer's channel points. Only unfulfilled
+// redemptions can be updated.
+func (c *Client) UpdateRedemptionStatus(broadcasterID, rewardID string, redemptionIDs []string, status string) error {
+	for start := 0; start < len(redemptionIDs); start += maxRedemptionsPerUpdate {
+		query := url.Values{}
+		query.Set("broadcaster_id", broadcasterID)
+		query.Set("reward_id", rewardID)
+
+		for _, redemptionID := range redemptionIDs[start:min(start+maxRedemptionsPerUpdate, len(redemptionIDs))] {
+			query.Add("id", redemptionID)
+		}
+
+		err := c.doRequest("PATCH", "/channel_points/custom_rewards/redemptions", query, map[string]string{"status": status}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,200 @@
+package helix
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+type ScheduleSegment struct {
+	ID            string `json:"id"`
+	StartTime     string `json:"start_time"`
+	EndTime       string `json:"end_time"`
+	Title         string `json:"title"`
+	CanceledUntil string `json:"canceled_until"`
+	Category      *struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"category"`
+	IsRecurring bool `json:"is_recurring"`
+}
+
+type Schedule struct {
+	Segments         []ScheduleSegment `json:"segments"`
+	BroadcasterID    string            `json:"broadcaster_id"`
+	BroadcasterName  string            `json:"broadcaster_name"`
+	BroadcasterLogin string            `json:"broadcaster_login"`
+	Vacation         *struct {
+		StartTime string `json:"start_time"`
+		EndTime   string `json:"end_time"`
+	} `json:"vacation"`
+}
+
+type GetScheduleResponse struct {
+	Data       Schedule   `json:"data"`
+	Pagination Pagination `json:"pagination"`
+}
+
+// GetScheduleSegment returns the segment with the given ID, or nil if the
+// broadcaster's schedule does not contain it.
+func (c *Client) GetScheduleSegment(broadcasterID, segmentID string) (*ScheduleSegment, error) {
+	query := url.Values{}
+	query.Set("broadcaster_id", broadcasterID)
+	query.Set("id", segmentID)
+
+	var scheduleResponse GetScheduleResponse
+	err := c.doRequest("GET", "/schedule", query, nil, &scheduleResponse)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, segment := range scheduleResponse.Data.Segments {
+		if segment.ID == segmentID {
+			return &segment, nil
+		}
+	}
+
+	return nil, nil
+}
+
+type CreateScheduleSegmentRequest struct {
+	StartTime   string `json:"start_time"`
+	Timezone    string `json:"timezone"`
+	Duration    string `json:"duration,omitempty"`
+	IsRecurring bool   `json:"is_recurring"`
+	CategoryID  string `json:"category_id,omitempty"`
+	Title       string `json:"title,omitempty"`
+}
+
+func (c *Client) CreateScheduleSegment(broadcasterID string, createRequest *CreateScheduleSegmentRequest) (*ScheduleSegment, error) {
+	query := url.Values{}
+	query.Set("broadcaster_id", broadcasterID)
+
+	var scheduleResponse GetScheduleResponse
+	err := c.doRequest("POST", "/schedule/segment", query, createRequest, &scheduleResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response only contains the segment that was added.
+	if len(scheduleResponse.Data.Segments) == 0 {
+		return nil, fmt.Errorf("no schedule segment returned for broadcaster %s", broadcasterID)
+	}
+
+	return &scheduleResponse.Data.Segments[0], nil
+}
+
+type UpdateScheduleSegmentRequest struct {
+	StartTime  string  `json:"start_time,omitempty"`
+	Duration   string  `json:"duration,omitempty"`
+	CategoryID *string `json:"category_id,omitempty"`
+	Title      *string `json:"title,omitempty"`
+	IsCanceled *bool   `json:"is_canceled,omitempty"`
+	Timezone   string  `json:"timezone,omitempty"`
+}
+
+func (c *Client) UpdateScheduleSegment(broadcasterID, segmentID string, updateRequest *UpdateScheduleSegmentRequest) (*ScheduleSegment, error) {
+	query := url.Values{}
+	query.Set("broadcaster_id", broadcasterID)
+	query.Set("id", segmentID)
+
+	var scheduleResponse GetScheduleResponse
+	err := c.doRequest("PATCH", "/schedule/segment", query, updateRequest, &scheduleResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response only contains the segment that was updated.
+	if len(scheduleResponse.Data.Segments) == 0 {
+		return nil, fmt.Errorf("no schedule segment returned for segment %s", segmentID)
+	}
+
+	return &scheduleResponse.Data.Segments[0], nil
+}
+
+func (c *Client) DeleteScheduleSegment(broadcasterID, segmentID string) error {
+	query := url.Values{}
+	query.Set("broadcaster_id", broadcasterID)
+	query.Set("id", segmentID)
+
+	return c.doRequest("DELETE", "/schedule/segment", query, nil, nil)
+}
+
+// GetSchedule returns up to limit upcoming segments of the broadcaster's
+// schedule starting at startTime, or now when startTime is empty. It returns
+// nil if the broadcaster has no schedule.
+func (c *Client) GetSchedule(broadcasterID, startTime string, limit int) (*Schedule, error) {
+	query := url.Values{}
+	query.Set("broadcaster_id", broadcasterID)
+	if startTime != "" {
+		query.Set("start_time", startTime)
+	}
+
+	var schedule *Schedule
+
+	for {
+		query.Set("first", strconv.Itoa(min(limit, 25)))
+
+		var scheduleResponse GetScheduleResponse
+		err := c.doRequest("GET", "/schedule", query, nil, &scheduleResponse)
+		if IsNotFound(err) {
+			return schedule, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if schedule == nil {
+			schedule = &scheduleResponse.Data
+		} else {
+			schedule.Segments = append(schedule.Segments, scheduleResponse.Data.Segments...)
+		}
+
+		limit -= len(scheduleResponse.Data.Segments)
+		if limit <= 0 || scheduleResponse.Pagination.Cursor == "" || len(scheduleResponse.Data.Segments) == 0 {
+			return schedule, nil
+		}
+
+		query.Set("after", scheduleResponse.Pagination.Cursor)
+	}
+}
+
+// GetScheduleICalendar returns the broadcaster's schedule as an iCalendar
+// document.
+func (c *Client) GetScheduleICalendar(broadcasterID string) (string, error) {
+	query := url.Values{}
+	query.Set("broadcaster_id", broadcasterID)
+
+	var calendar []byte
+	err := c.doRequest("GET", "/schedule/icalendar", query, nil, &calendar)
+	if err != nil {
+		return "", err
+	}
+
+	return string(calendar), nil
+}
+
+type UpdateScheduleSettingsRequest struct {
+	IsVacationEnabled bool
+	VacationStartTime string
+	VacationEndTime   string
+	Timezone          string
+}
+
+func (c *Client) UpdateScheduleSettings(broadcasterID string, updateRequest *UpdateScheduleSettingsRequest) error {
+	query := url.Values{}
+	query.Set("broadcaster_id", broadcasterID)
+	query.Set("is_vacation_enabled", strconv.FormatBool(updateRequest.IsVacationEnabled))
+	if updateRequest.IsVacationEnabled {
+		query.Set("vacation_start_time", updateRequest.VacationStartTime)
+		query.Set("vacation_end_time", updateRequest.VacationEndTime)
+		query.Set("timezone", updateRequest.Timezone)
+	}
+
+	return c.doRequest("PATCH", "/schedule/settings", query, nil, nil)
+}
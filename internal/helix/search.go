@@ -0,0 +1,20 @@
+package helix
+
+import (
+	"net/url"
+)
+
+type Category struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	BoxArtURL string `json:"box_art_url"`
+}
+
+// SearchCategories returns up to limit categories whose names match query,
+// most relevant first. Twitch returns at most 100 results per request.
+func (c *Client) SearchCategories(query string, limit int) ([]Category, error) {
+	params := url.Values{}
+	params.Set("query", query)
+
+	return getPages[Category](c, "/search/categories", params, limit)
+}
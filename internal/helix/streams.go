@@ -0,0 +1,52 @@
+package helix
+
+import (
+	"net/url"
+)
+
+type Stream struct {
+	ID           string   `json:"id"`
+	UserID       string   `json:"user_id"`
+	UserLogin    string   `json:"user_login"`
+	UserName     string   `json:"user_name"`
+	GameID       string   `json:"game_id"`
+	GameName     string   `json:"game_name"`
+	Type         string   `json:"type"`
+	Title        string   `json:"title"`
+	Tags         []string `json:"tags"`
+	ViewerCount  int      `json:"viewer_count"`
+	StartedAt    string   `json:"started_at"`
+	Language     string   `json:"language"`
+	ThumbnailURL string   `json:"thumbnail_url"`
+	IsMature     bool     `json:"is_mature"`
+}
+
+type GetStreamsResponse struct {
+	Data       []Stream   `json:"data"`
+	Pagination Pagination `json:"pagination"`
+}
+
+// GetStream returns the live stream of the given user, looked up by ID or
+// login, or nil if the user is offline.
+func (c *Client) GetStream(userID, userLogin string) (*Stream, error) {
+	query := url.Values{}
+	if userID != "" {
+		query.Set("user_id", userID)
+	}
+
+	if userLogin != "" {
+		query.Set("user_login", userLogin)
+	}
+
+	var streamsResponse GetStreamsResponse
+	err := c.doRequest("GET", "/streams", query, nil, &streamsResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(streamsResponse.Data) == 0 {
+		return nil, nil
+	}
+
+	return &streamsResponse.Data[0], nil
+}
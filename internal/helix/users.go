@@ -0,0 +1,82 @@
+package helix
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// maxUsersPerRequest is the combined number of IDs and logins Get Users
+// accepts at once.
+const maxUsersPerRequest = 100
+
+type User struct {
+	ID              string `json:"id"`
+	Login           string `json:"login"`
+	DisplayName     string `json:"display_name"`
+	Type            string `json:"type"`
+	BroadcasterType string `json:"broadcaster_type"`
+	Description     string `json:"description"`
+	ProfileImageURL string `json:"profile_image_url"`
+	OfflineImageURL string `json:"offline_image_url"`
+	CreatedAt       string `json:"created_at"`
+}
+
+type GetUsersResponse struct {
+	Data []User `json:"data"`
+}
+
+// GetUsers looks up users by ID and login, batching the lookups into requests
+// of at most 100. With no IDs or logins it returns the user the access token
+// belongs to.
+func (c *Client) GetUsers(ids, logins []string) ([]User, error) {
+	type lookup struct {
+		key, value string
+	}
+
+	lookups := []lookup{}
+	for _, id := range ids {
+		lookups = append(lookups, lookup{"id", id})
+	}
+
+	for _, login := range logins {
+		lookups = append(lookups, lookup{"login", login})
+	}
+
+	users := []User{}
+
+	for start := 0; start == 0 || start < len(lookups); start += maxUsersPerRequest {
+		query := url.Values{}
+		for _, l := range lookups[start:min(start+maxUsersPerRequest, len(lookups))] {
+			query.Add(l.key, l.value)
+		}
+
+		var usersResponse GetUsersResponse
+		err := c.doRequest("GET", "/users", query, nil, &usersResponse)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, usersResponse.Data...)
+	}
+
+	return users, nil
+}
+
+// UpdateUserDescription sets the channel description of the user the access
+// token belongs to.
+func (c *Client) UpdateUserDescription(description string) (*User, error) {
+	query := url.Values{}
+	query.Set("description", description)
+
+	var usersResponse GetUsersResponse
+	err := c.doRequest("PUT", "/users", query, nil, &usersResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(usersResponse.Data) == 0 {
+		return nil, fmt.Errorf("no user returned")
+	}
+
+	return &usersResponse.Data[0], nil
+}
@@ -0,0 +1,280 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ell/terraform-provider-twitch/internal/helix"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                     = &autoModSettingsResource{}
+	_ resource.ResourceWithConfigure        = &autoModSettingsResource{}
+	_ resource.ResourceWithConfigValidators = &autoModSettingsResource{}
+	_ resource.ResourceWithImportState      = &autoModSettingsResource{}
+)
+
+// autoModCategories lists the individual AutoMod category attributes, which
+// are mutually exclusive with overall_level.
+var autoModCategories = []string{
+	"aggression",
+	"bullying",
+	"disability",
+	"misogyny",
+	"race_ethnicity_or_religion",
+	"sex_based_terms",
+	"sexuality_sex_or_gender",
+	"swearing",
+}
+
+type autoModSettingsResource struct {
+	TwitchClient *helix.Client
+}
+
+func (a *autoModSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	twitchClient, ok := req.ProviderData.(*helix.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *helix.Client, got %T", req.ProviderData))
+
+		return
+	}
+
+	a.TwitchClient = twitchClient
+}
+
+func NewAutoModSettingsResource() resource.Resource {
+	return &autoModSettingsResource{}
+}
+
+func (a *autoModSettingsResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"broadcaster_id": schema.StringAttribute{
+			Required: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"moderator_id": schema.StringAttribute{
+			MarkdownDescription: "User the access token belongs to. Defaults to `broadcaster_id`.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"overall_level": schema.Int32Attribute{
+			MarkdownDescription: "Overall AutoMod level from 0 to 4. Conflicts with the individual category levels.",
+			Optional:            true,
+			Validators: []validator.Int32{
+				int32validator.Between(0, 4),
+			},
+		},
+	}
+
+	for _, category := range autoModCategories {
+		attributes[category] = schema.Int32Attribute{
+			Optional: true,
+			Computed: true,
+			Validators: []validator.Int32{
+				int32validator.Between(0, 4),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a broadcaster's AutoMod settings. Destroying the resource turns AutoMod off.",
+		Attributes:          attributes,
+	}
+}
+
+func (a *autoModSettingsResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	validators := []resource.ConfigValidator{}
+
+	for _, category := range autoModCategories {
+		validators = append(validators, resourcevalidator.Conflicting(
+			path.MatchRoot("overall_level"),
+			path.MatchRoot(category),
+		))
+	}
+
+	return validators
+}
+
+type autoModSettingsResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	BroadcasterID           types.String `tfsdk:"broadcaster_id"`
+	ModeratorID             types.String `tfsdk:"moderator_id"`
+	OverallLevel            types.Int32  `tfsdk:"overall_level"`
+	Aggression              types.Int32  `tfsdk:"aggression"`
+	Bullying                types.Int32  `tfsdk:"bullying"`
+	Disability              types.Int32  `tfsdk:"disability"`
+	Misogyny                types.Int32  `tfsdk:"misogyny"`
+	RaceEthnicityOrReligion types.Int32  `tfsdk:"race_ethnicity_or_religion"`
+	SexBasedTerms           types.Int32  `tfsdk:"sex_based_terms"`
+	SexualitySexOrGender    types.Int32  `tfsdk:"sexuality_sex_or_gender"`
+	Swearing                types.Int32  `tfsdk:"swearing"`
+}
+
+func (m *autoModSettingsResourceModel) moderatorID() string {
+	return moderatorIDOrBroadcaster(m.ModeratorID, m.BroadcasterID)
+}
+
+func (m *autoModSettingsResourceModel) updateRequest() *helix.UpdateAutoModSettingsRequest {
+	level := func(value types.Int32) *int {
+		if value.IsNull() || value.IsUnknown() {
+			return nil
+		}
+
+		v := int(value.ValueInt32())
+
+		return &v
+	}
+
+	if !m.OverallLevel.IsNull() {
+		return &helix.UpdateAutoModSettingsRequest{
+			OverallLevel: level(m.OverallLevel),
+		}
+	}
+
+	return &helix.UpdateAutoModSettingsRequest{
+		Aggression:              level(m.Aggression),
+		Bullying:                level(m.Bullying),
+		Disability:              level(m.Disability),
+		Misogyny:                level(m.Misogyny),
+		RaceEthnicityOrReligion: level(m.RaceEthnicityOrReligion),
+		SexBasedTerms:           level(m.SexBasedTerms),
+		SexualitySexOrGender:    level(m.SexualitySexOrGender),
+		Swearing:                level(m.Swearing),
+	}
+}
+
+func (m *autoModSettingsResourceModel) setSettings(settings *helix.AutoModSettings) {
+	m.ID = types.StringValue(settings.BroadcasterID)
+	m.BroadcasterID = types.StringValue(settings.BroadcasterID)
+	m.ModeratorID = types.StringValue(settings.ModeratorID)
+
+	m.OverallLevel = types.Int32Null()
+	if settings.OverallLevel != nil {
+		m.OverallLevel = types.Int32Value(int32(*settings.OverallLevel))
+	}
+
+	m.Aggression = types.Int32Value(int32(settings.Aggression))
+	m.Bullying = types.Int32Value(int32(settings.Bullying))
+	m.Disability = types.Int32Value(int32(settings.Disability))
+	m.Misogyny = types.Int32Value(int32(settings.Misogyny))
+	m.RaceEthnicityOrReligion = types.Int32Value(int32(settings.RaceEthnicityOrReligion))
+	m.SexBasedTerms = types.Int32Value(int32(settings.SexBasedTerms))
+	m.SexualitySexOrGender = types.Int32Value(int32(settings.SexualitySexOrGender))
+	m.Swearing = types.Int32Value(int32(settings.Swearing))
+}
+
+func (a *autoModSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_automod_settings"
+}
+
+func (a *autoModSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan autoModSettingsResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := a.TwitchClient.UpdateAutoModSettings(plan.BroadcasterID.ValueString(), plan.moderatorID(), plan.updateRequest())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update automod settings", err.Error())
+		return
+	}
+
+	plan.setSettings(settings)
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (a *autoModSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state autoModSettingsResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := a.TwitchClient.GetAutoModSettings(state.BroadcasterID.ValueString(), state.moderatorID())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get automod settings", err.Error())
+		return
+	}
+
+	state.setSettings(settings)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (a *autoModSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan autoModSettingsResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := a.TwitchClient.UpdateAutoModSettings(plan.BroadcasterID.ValueString(), plan.moderatorID(), plan.updateRequest())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update automod settings", err.Error())
+		return
+	}
+
+	plan.setSettings(settings)
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (a *autoModSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state autoModSettingsResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	off := 0
+	_, err := a.TwitchClient.UpdateAutoModSettings(state.BroadcasterID.ValueString(), state.moderatorID(), &helix.UpdateAutoModSettingsRequest{
+		OverallLevel: &off,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to reset automod settings", err.Error())
+		return
+	}
+}
+
+func (a *autoModSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("broadcaster_id"), req, resp)
+}
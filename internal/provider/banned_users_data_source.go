@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/ell/terraform-provider-twitch/internal/helix"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &bannedUsersDataSource{}
+	_ datasource.DataSourceWithConfigure = &bannedUsersDataSource{}
+)
+
+type bannedUsersDataSource struct {
+	client *helix.Client
+}
+
+type bannedUsersDataSourceModel struct {
+	BroadcasterID types.String      `tfsdk:"broadcaster_id"`
+	UserIDs       []types.String    `tfsdk:"user_ids"`
+	BannedUsers   []bannedUserModel `tfsdk:"banned_users"`
+}
+
+type bannedUserModel struct {
+	UserID         types.String `tfsdk:"user_id"`
+	UserLogin      types.String `tfsdk:"user_login"`
+	UserName       types.String `tfsdk:"user_name"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	Reason         types.String `tfsdk:"reason"`
+	ModeratorID    types.String `tfsdk:"moderator_id"`
+	ModeratorLogin types.String `tfsdk:"moderator_login"`
+	ModeratorName  types.String `tfsdk:"moderator_name"`
+}
+
+func (b *bannedUsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*helix.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected DataSource Configure Type", "Expected *helix.Client")
+
+		return
+	}
+
+	b.client = client
+}
+
+func (b *bannedUsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_banned_users"
+}
+
+func (b *bannedUsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state bannedUsersDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userIDs := []string{}
+	for _, userID := range state.UserIDs {
+		userIDs = append(userIDs, userID.ValueString())
+	}
+
+	bannedUsers, err := b.client.GetBannedUsers(state.BroadcasterID.ValueString(), userIDs)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get banned users", err.Error())
+
+		return
+	}
+
+	state.BannedUsers = []bannedUserModel{}
+	for _, bannedUser := range bannedUsers {
+		state.BannedUsers = append(state.BannedUsers, bannedUserModel{
+			UserID:         types.StringValue(bannedUser.UserID),
+			UserLogin:      types.StringValue(bannedUser.UserLogin),
+			UserName:       types.StringValue(bannedUser.UserName),
+			ExpiresAt:      types.StringValue(bannedUser.ExpiresAt),
+			CreatedAt:      types.StringValue(bannedUser.CreatedAt),
+			Reason:         types.StringValue(bannedUser.Reason),
+			ModeratorID:    types.StringValue(bannedUser.ModeratorID),
+			ModeratorLogin: types.StringValue(bannedUser.ModeratorLogin),
+			ModeratorName:  types.StringValue(bannedUser.ModeratorName),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (b *bannedUsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"broadcaster_id": schema.StringAttribute{
+				Required: true,
+			},
+			"user_ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(100),
+				},
+			},
+			"banned_users": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"user_login": schema.StringAttribute{
+							Computed: true,
+						},
+						"user_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"expires_at": schema.StringAttribute{
+							Computed: true,
+						},
+						"created_at": schema.StringAttribute{
+							Computed: true,
+						},
+						"reason": schema.StringAttribute{
+							Computed: true,
+						},
+						"moderator_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"moderator_login": schema.StringAttribute{
+							Computed: true,
+						},
+						"moderator_name": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func NewBannedUsersDataSource() datasource.DataSource {
+	return &bannedUsersDataSource{}
+}
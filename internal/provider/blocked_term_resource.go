@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ell/terraform-provider-twitch/internal/helix"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &blockedTermResource{}
+	_ resource.ResourceWithConfigure   = &blockedTermResource{}
+	_ resource.ResourceWithImportState = &blockedTermResource{}
+)
+
+type blockedTermResource struct {
+	TwitchClient *helix.Client
+}
+
+func (b *blockedTermResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	twitchClient, ok := req.ProviderData.(*helix.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *helix.Client, got %T", req.ProviderData))
+
+		return
+	}
+
+	b.TwitchClient = twitchClient
+}
+
+func NewBlockedTermResource() resource.Resource {
+	return &blockedTermResource{}
+}
+
+func (b *blockedTermResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a single blocked term. Import with `broadcaster_id/id`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"broadcaster_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"moderator_id": schema.StringAttribute{
+				MarkdownDescription: "User the access token belongs to. Defaults to `broadcaster_id`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"text": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 500),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type blockedTermResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	BroadcasterID types.String `tfsdk:"broadcaster_id"`
+	ModeratorID   types.String `tfsdk:"moderator_id"`
+	Text          types.String `tfsdk:"text"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+}
+
+func (b *blockedTermResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blocked_term"
+}
+
+func (b *blockedTermResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan blockedTermResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	moderatorID := moderatorIDOrBroadcaster(plan.ModeratorID, plan.BroadcasterID)
+
+	term, err := b.TwitchClient.AddBlockedTerm(plan.BroadcasterID.ValueString(), moderatorID, plan.Text.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to add blocked term", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(term.ID)
+	plan.ModeratorID = types.StringValue(moderatorID)
+	plan.CreatedAt = types.StringValue(term.CreatedAt)
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (b *blockedTermResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state blockedTermResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	moderatorID := moderatorIDOrBroadcaster(state.ModeratorID, state.BroadcasterID)
+
+	term, err := b.TwitchClient.GetBlockedTermByID(state.BroadcasterID.ValueString(), moderatorID, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get blocked term", err.Error())
+		return
+	}
+
+	if term == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ModeratorID = types.StringValue(moderatorID)
+	state.Text = types.StringValue(term.Text)
+	state.CreatedAt = types.StringValue(term.CreatedAt)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is only reached when nothing but computed values changed, since every
+// configurable attribute requires replacement.
+func (b *blockedTermResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan blockedTermResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (b *blockedTermResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state blockedTermResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	moderatorID := moderatorIDOrBroadcaster(state.ModeratorID, state.BroadcasterID)
+
+	err := b.TwitchClient.RemoveBlockedTerm(state.BroadcasterID.ValueString(), moderatorID, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to remove blocked term", err.Error())
+		return
+	}
+}
+
+func (b *blockedTermResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateParts(ctx, req, resp, "broadcaster_id", "id")
+}
@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ell/terraform-provider-twitch/internal/helix"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &blockedTermsResource{}
+	_ resource.ResourceWithConfigure   = &blockedTermsResource{}
+	_ resource.ResourceWithImportState = &blockedTermsResource{}
+)
+
+type blockedTermsResource struct {
+	TwitchClient *helix.Client
+}
+
+func (b *blockedTermsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	twitchClient, ok := req.ProviderData.(*helix.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *helix.Client, got %T", req.ProviderData))
+
+		return
+	}
+
+	b.TwitchClient = twitchClient
+}
+
+func NewBlockedTermsResource() resource.Resource {
+	return &blockedTermsResource{}
+}
+
+func (b *blockedTermsResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the complete list of blocked terms for a broadcaster. " +
+			"Terms added outside of Terraform are removed on the next apply. Do not combine with `twitch_blocked_term` for the same broadcaster.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"broadcaster_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"moderator_id": schema.StringAttribute{
+				MarkdownDescription: "User the access token belongs to. Defaults to `broadcaster_id`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"terms": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthBetween(2, 500)),
+				},
+			},
+		},
+	}
+}
+
+type blockedTermsResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	BroadcasterID types.String   `tfsdk:"broadcaster_id"`
+	ModeratorID   types.String   `tfsdk:"moderator_id"`
+	Terms         []types.String `tfsdk:"terms"`
+}
+
+func (b *blockedTermsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blocked_terms"
+}
+
+// syncBlockedTerms adds and removes blocked terms until the broadcaster's list
+// matches terms exactly.
+func (b *blockedTermsResource) syncBlockedTerms(broadcasterID, moderatorID string, terms []types.String) error {
+	existing, err := b.TwitchClient.GetBlockedTerms(broadcasterID, moderatorID)
+	if err != nil {
+		return err
+	}
+
+	wanted := map[string]bool{}
+	for _, term := range terms {
+		wanted[term.ValueString()] = true
+	}
+
+	for _, term := range existing {
+		if wanted[term.Text] {
+			delete(wanted, term.Text)
+			continue
+		}
+
+		err = b.TwitchClient.RemoveBlockedTerm(broadcasterID, moderatorID, term.ID)
+		if err != nil {
+			return fmt.Errorf("removing %q: %w", term.Text, err)
+		}
+	}
+
+	for _, term := range terms {
+		if !wanted[term.ValueString()] {
+			continue
+		}
+
+		_, err = b.TwitchClient.AddBlockedTerm(broadcasterID, moderatorID, term.ValueString())
+		if err != nil {
+			return fmt.Errorf("adding %q: %w", term.ValueString(), err)
+		}
+	}
+
+	return nil
+}
+
+func (b *blockedTermsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan blockedTermsResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	moderatorID := moderatorIDOrBroadcaster(plan.ModeratorID, plan.BroadcasterID)
+
+	err := b.syncBlockedTerms(plan.BroadcasterID.ValueString(), moderatorID, plan.Terms)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update blocked terms", err.Error())
+		return
+	}
+
+	plan.ID = plan.BroadcasterID
+	plan.ModeratorID = types.StringValue(moderatorID)
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (b *blockedTermsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state blockedTermsResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	moderatorID := moderatorIDOrBroadcaster(state.ModeratorID, state.BroadcasterID)
+
+	terms, err := b.TwitchClient.GetBlockedTerms(state.BroadcasterID.ValueString(), moderatorID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get blocked terms", err.Error())
+		return
+	}
+
+	state.ModeratorID = types.StringValue(moderatorID)
+
+	state.Terms = []types.String{}
+	for _, term := range terms {
+		state.Terms = append(state.Terms, types.StringValue(term.Text))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (b *blockedTermsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan blockedTermsResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	moderatorID := moderatorIDOrBroadcaster(plan.ModeratorID, plan.BroadcasterID)
+
+	err := b.syncBlockedTerms(plan.BroadcasterID.ValueString(), moderatorID, plan.Terms)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update blocked terms", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (b *blockedTermsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state blockedTermsResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	moderatorID := moderatorIDOrBroadcaster(state.ModeratorID, state.BroadcasterID)
+
+	err := b.syncBlockedTerms(state.BroadcasterID.ValueString(), moderatorID, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to remove blocked terms", err.Error())
+		return
+	}
+}
+
+func (b *blockedTermsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("broadcaster_id"), req, resp)
+}
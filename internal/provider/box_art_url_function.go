@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/ell/terraform-provider-twitch/internal/helix"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &boxArtURLFunction{}
+
+// boxArtSizes are the box art sizes Twitch itself uses, keyed as
+// "{width}x{height}".
+var boxArtSizes = map[string][2]int{
+	"52x72":   {52, 72},
+	"144x192": {144, 192},
+	"285x380": {285, 380},
+}
+
+type boxArtURLFunction struct{}
+
+func NewBoxArtURLFunction() function.Function {
+	return &boxArtURLFunction{}
+}
+
+func (b *boxArtURLFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "box_art_url"
+}
+
+func (b *boxArtURLFunction) Definition(_ context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Render a box art URL at a given size",
+		MarkdownDescription: "Replaces the `{width}` and `{height}` placeholders of a `box_art_url` with concrete pixel sizes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "url",
+				MarkdownDescription: "Box art URL template, as returned by `twitch_game`.",
+			},
+			function.Int64Parameter{
+				Name: "width",
+			},
+			function.Int64Parameter{
+				Name: "height",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (b *boxArtURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var template string
+	var width, height int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &template, &width, &height))
+	if resp.Error != nil {
+		return
+	}
+
+	if width < 1 {
+		resp.Error = function.NewArgumentFuncError(1, "width must be at least 1")
+		return
+	}
+
+	if height < 1 {
+		resp.Error = function.NewArgumentFuncError(2, "height must be at least 1")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, helix.RenderBoxArtURL(template, int(width), int(height))))
+}
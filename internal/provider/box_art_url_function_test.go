@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBoxArtURLFunction(t *testing.T) {
+	const template = "https://static-cdn.jtvnw.net/ttv-boxart/509658-{width}x{height}.jpg"
+
+	testFunction(t, NewBoxArtURLFunction(), types.StringUnknown(), []functionTestCase{
+		{
+			name:      "renders size",
+			arguments: []attr.Value{types.StringValue(template), types.Int64Value(285), types.Int64Value(380)},
+			want:      types.StringValue("https://static-cdn.jtvnw.net/ttv-boxart/509658-285x380.jpg"),
+		},
+		{
+			name:      "without placeholders",
+			arguments: []attr.Value{types.StringValue("https://example.com/box.jpg"), types.Int64Value(52), types.Int64Value(72)},
+			want:      types.StringValue("https://example.com/box.jpg"),
+		},
+		{
+			name:      "zero width",
+			arguments: []attr.Value{types.StringValue(template), types.Int64Value(0), types.Int64Value(380)},
+			wantErr:   function.NewArgumentFuncError(1, "width must be at least 1"),
+		},
+		{
+			name:      "negative height",
+			arguments: []attr.Value{types.StringValue(template), types.Int64Value(285), types.Int64Value(-1)},
+			wantErr:   function.NewArgumentFuncError(2, "height must be at least 1"),
+		},
+	})
+}
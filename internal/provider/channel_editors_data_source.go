@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/ell/terraform-provider-twitch/internal/helix"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &channelEditorsDataSource{}
+	_ datasource.DataSourceWithConfigure = &channelEditorsDataSource{}
+)
+
+type channelEditorsDataSource struct {
+	client *helix.Client
+}
+
+type channelEditorsDataSourceModel struct {
+	BroadcasterID types.String         `tfsdk:"broadcaster_id"`
+	Editors       []channelEditorModel `tfsdk:"editors"`
+}
+
+type channelEditorModel struct {
+	UserID    types.String `tfsdk:"user_id"`
+	UserName  types.String `tfsdk:"user_name"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+func (c *channelEditorsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*helix.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected DataSource Configure Type", "Expected *helix.Client")
+
+		return
+	}
+
+	c.client = client
+}
+
+func (c *channelEditorsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_editors"
+}
+
+func (c *channelEditorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state channelEditorsDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	editors, err := c.client.GetChannelEditors(state.BroadcasterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get channel editors", err.Error())
+
+		return
+	}
+
+	state.Editors = []channelEditorModel{}
+	for _, editor := range editors {
+		state.Editors = append(state.Editors, channelEditorModel{
+			UserID:    types.StringValue(editor.UserID),
+			UserName:  types.StringValue(editor.UserName),
+			CreatedAt: types.StringValue(editor.CreatedAt),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *channelEditorsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the editors of a channel. Requires a token of the broadcaster with the `channel:read:editors` scope.",
+		Attributes: map[string]schema.Attribute{
+			"broadcaster_id": schema.StringAttribute{
+				Required: true,
+			},
+			"editors": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"user_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "When the user became an editor.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func NewChannelEditorsDataSource() datasource.DataSource {
+	return &channelEditorsDataSource{}
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/ell/terraform-provider-twitch/internal/helix"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &channelFollowersDataSource{}
+	_ datasource.DataSourceWithConfigure = &channelFollowersDataSource{}
+)
+
+type channelFollowersDataSource struct {
+	client *helix.Client
+}
+
+type channelFollowersDataSourceModel struct {
+	BroadcasterID types.String           `tfsdk:"broadcaster_id"`
+	Limit         types.Int32            `tfsdk:"limit"`
+	Total         types.Int64            `tfsdk:"total"`
+	Followers     []channelFollowerModel `tfsdk:"followers"`
+}
+
+type channelFollowerModel struct {
+	UserID     types.String `tfsdk:"user_id"`
+	UserLogin  types.String `tfsdk:"user_login"`
+	UserName   types.String `tfsdk:"user_name"`
+	FollowedAt types.String `tfsdk:"followed_at"`
+}
+
+func (c *channelFollowersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*helix.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected DataSource Configure Type", "Expected *helix.Client")
+
+		return
+	}
+
+	c.client = client
+}
+
+func (c *channelFollowersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_followers"
+}
+
+func (c *channelFollowersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state channelFollowersDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	total, followers, err := c.client.GetChannelFollowers(state.BroadcasterID.ValueString(), int(state.Limit.ValueInt32()))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get channel followers", err.Error())
+
+		return
+	}
+
+	state.Total = types.Int64Value(int64(total))
+
+	state.Followers = []channelFollowerModel{}
+	for _, follower := range followers {
+		state.Followers = append(state.Followers, channelFollowerModel{
+			UserID:     types.StringValue(follower.UserID),
+			UserLogin:  types.StringValue(follower.UserLogin),
+			UserName:   types.StringValue(follower.UserName),
+			FollowedAt: types.StringValue(follower.FollowedAt),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *channelFollowersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reports the follower count of a channel and optionally lists its most recent followers. " +
+			"Listing followers requires the token owner to be the broadcaster or one of its moderators.",
+		Attributes: map[string]schema.Attribute{
+			"broadcaster_id": schema.StringAttribute{
+				Required: true,
+			},
+			"limit": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of followers to list, most recent first. Defaults to 0, which only reports `total`.",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.Between(0, 1000),
+				},
+			},
+			"total": schema.Int64Attribute{
+				Computed: true,
+			},
+			"followers": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"user_login": schema.StringAttribute{
+							Computed: true,
+						},
+						"user_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"followed_at": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func NewChannelFollowersDataSource() datasource.DataSource {
+	return &channelFollowersDataSource{}
+}
@@ -3,11 +3,18 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/ell/terraform-provider-twitch/internal/helix"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -15,6 +22,7 @@ var (
 	_ resource.Resource                = &channelResource{}
 	_ resource.ResourceWithConfigure   = &channelResource{}
 	_ resource.ResourceWithImportState = &channelResource{}
+	_ resource.ResourceWithModifyPlan  = &channelResource{}
 )
 
 type channelResource struct {
@@ -48,22 +56,138 @@ func (c *channelResource) Schema(_ context.Context, req resource.SchemaRequest,
 			"title": schema.StringAttribute{
 				Required: true,
 			},
-			"tags": schema.ListAttribute{
-				ElementType: types.StringType,
-				Required:    true,
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Up to 10 tags of at most 25 letters and digits each, unique ignoring case.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(10),
+					setvalidator.ValueStringsAre(
+						stringvalidator.UTF8LengthBetween(1, maxTagLength),
+						channelTagValidator{},
+					),
+					uniqueTagsValidator{},
+				},
 			},
 			"game_id": schema.StringAttribute{
 				Required: true,
 			},
+			"content_classification_labels": schema.SetAttribute{
+				MarkdownDescription: "IDs of the content classification labels to apply; the others are removed. " +
+					"See `twitch_content_classification_labels` for valid IDs. `MatureGame` is set by Twitch from the game " +
+					"and is ignored. Left unmanaged when unset.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.NoneOf(helix.ContentClassificationLabelMatureGame),
+					),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
 
+// ModifyPlan checks changed content_classification_labels against the labels
+// Twitch currently offers.
+func (c *channelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || c.TwitchClient == nil {
+		return
+	}
+
+	var configured types.Set
+
+	diags := req.Config.GetAttribute(ctx, path.Root("content_classification_labels"), &configured)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || configured.IsNull() || configured.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var current types.Set
+
+		diags = req.State.GetAttribute(ctx, path.Root("content_classification_labels"), &current)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() || configured.Equal(current) {
+			return
+		}
+	}
+
+	labels, err := c.TwitchClient.GetContentClassificationLabels(defaultContentClassificationLocale)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get content classification labels", err.Error())
+		return
+	}
+
+	valid := map[string]bool{}
+	for _, label := range labels {
+		valid[label.ID] = true
+	}
+
+	for _, element := range configured.Elements() {
+		labelID, ok := element.(types.String)
+		if !ok || labelID.IsUnknown() || valid[labelID.ValueString()] {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeError(path.Root("content_classification_labels"), "Unknown content classification label",
+			fmt.Sprintf("%q is not a content classification label. See the twitch_content_classification_labels data source for valid IDs.", labelID.ValueString()))
+	}
+}
+
+// contentClassificationLabelSettings enables the configured labels and
+// disables every other label Twitch offers, except MatureGame.
+func (c *channelResource) contentClassificationLabelSettings(ctx context.Context, configured types.Set) ([]helix.ContentClassificationLabelSetting, error) {
+	enabled := []string{}
+
+	diags := configured.ElementsAs(ctx, &enabled, false)
+	if diags.HasError() {
+		return nil, fmt.Errorf("reading content_classification_labels")
+	}
+
+	labels, err := c.TwitchClient.GetContentClassificationLabels(defaultContentClassificationLocale)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := []helix.ContentClassificationLabelSetting{}
+	for _, label := range labels {
+		if label.ID == helix.ContentClassificationLabelMatureGame {
+			continue
+		}
+
+		settings = append(settings, helix.ContentClassificationLabelSetting{
+			ID:        label.ID,
+			IsEnabled: slices.Contains(enabled, label.ID),
+		})
+	}
+
+	return settings, nil
+}
+
+// readContentClassificationLabels converts the labels of a channel to
+// content_classification_labels, leaving out MatureGame.
+func readContentClassificationLabels(labelIDs []string) types.Set {
+	elements := []attr.Value{}
+	for _, labelID := range labelIDs {
+		if labelID != helix.ContentClassificationLabelMatureGame {
+			elements = append(elements, types.StringValue(labelID))
+		}
+	}
+
+	return types.SetValueMust(types.StringType, elements)
+}
+
 type channelResourceModel struct {
-	ID     types.String   `tfsdk:"id"`
-	Title  types.String   `tfsdk:"title"`
-	Tags   []types.String `tfsdk:"tags"`
-	GameID types.String   `tfsdk:"game_id"`
+	ID                          types.String   `tfsdk:"id"`
+	Title                       types.String   `tfsdk:"title"`
+	Tags                        []types.String `tfsdk:"tags"`
+	GameID                      types.String   `tfsdk:"game_id"`
+	ContentClassificationLabels types.Set      `tfsdk:"content_classification_labels"`
 }
 
 func (c *channelResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -105,6 +229,8 @@ func (c *channelResource) Read(ctx context.Context, req resource.ReadRequest, re
 		state.Tags = append(state.Tags, types.StringValue(tag))
 	}
 
+	state.ContentClassificationLabels = readContentClassificationLabels(channelInfo.ContentClassification)
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 
@@ -140,6 +266,25 @@ func (c *channelResource) Update(ctx context.Context, req resource.UpdateRequest
 		}
 	}
 
+	// Labels are only sent when configured, so unset leaves them unmanaged.
+	var configuredLabels types.Set
+
+	diags = req.Config.GetAttribute(ctx, path.Root("content_classification_labels"), &configuredLabels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !configuredLabels.IsNull() {
+		settings, err := c.contentClassificationLabelSettings(ctx, configuredLabels)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to build content classification labels", err.Error())
+			return
+		}
+
+		updateRequest.ContentClassificationLabels = settings
+	}
+
 	err := c.TwitchClient.UpdateChannel(state.ID.ValueString(), updateRequest)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to update channel", err.Error())
@@ -161,6 +306,7 @@ func (c *channelResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	state.Title = types.StringValue(channelInfo.Title)
 	state.GameID = types.StringValue(channelInfo.GameId)
+	state.ContentClassificationLabels = readContentClassificationLabels(channelInfo.ContentClassification)
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
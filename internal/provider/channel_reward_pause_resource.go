@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ell/terraform-provider-twitch/internal/helix"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource              = &channelRewardPauseResource{}
+	_ resource.ResourceWithConfigure = &channelRewardPauseResource{}
+)
+
+type channelRewardPauseResource struct {
+	TwitchClient *helix.Client
+}
+
+func (c *channelRewardPauseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	twitchClient, ok := req.ProviderData.(*helix.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *helix.Client, got %T", req.ProviderData))
+
+		return
+	}
+
+	c.TwitchClient = twitchClient
+}
+
+func NewChannelRewardPauseResource() resource.Resource {
+	return &channelRewardPauseResource{}
+}
+
+func (c *channelRewardPauseResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Pauses redemptions of a group of custom rewards while the resource exists, and resumes them " +
+			"when it is destroyed. Leave `is_paused` unset on the paused `twitch_channel_reward` resources and " +
+			"`twitch_channel_reward_set` rewards.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"broadcaster_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"reward_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+type channelRewardPauseResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	BroadcasterID types.String   `tfsdk:"broadcaster_id"`
+	RewardIDs     []types.String `tfsdk:"reward_ids"`
+}
+
+func (c *channelRewardPauseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_reward_pause"
+}
+
+// setPaused pauses or resumes every reward in rewardIDs.
+func (c *channelRewardPauseResource) setPaused(broadcasterID string, rewardIDs []types.String, paused bool) error {
+	for _, rewardID := range rewardIDs {
+		_, err := c.TwitchClient.SetChannelRewardPaused(broadcasterID, rewardID.ValueString(), paused)
+		if err != nil {
+			return fmt.Errorf("reward %s: %w", rewardID.ValueString(), err)
+		}
+	}
+
+	return nil
+}
+
+func (c *channelRewardPauseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan channelRewardPauseResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.setPaused(plan.BroadcasterID.ValueString(), plan.RewardIDs, true)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to pause channel rewards", err.Error())
+		return
+	}
+
+	plan.ID = plan.BroadcasterID
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read drops rewards that were deleted or resumed outside Terraform, so the
+// next plan pauses them again.
+func (c *channelRewardPauseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state channelRewardPauseResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rewards, err := c.TwitchClient.GetChannelRewards(state.BroadcasterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get channel rewards", err.Error())
+		return
+	}
+
+	paused := map[string]bool{}
+	for _, reward := range *rewards {
+		paused[reward.ID] = reward.IsPaused
+	}
+
+	rewardIDs := []types.String{}
+	for _, rewardID := range state.RewardIDs {
+		if paused[rewardID.ValueString()] {
+			rewardIDs = append(rewardIDs, rewardID)
+		}
+	}
+
+	state.RewardIDs = rewardIDs
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *channelRewardPauseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state channelRewardPauseResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned := map[string]bool{}
+	for _, rewardID := range plan.RewardIDs {
+		planned[rewardID.ValueString()] = true
+	}
+
+	removed := []types.String{}
+	for _, rewardID := range state.RewardIDs {
+		if !planned[rewardID.ValueString()] {
+			removed = append(removed, rewardID)
+		}
+	}
+
+	err := c.setPaused(plan.BroadcasterID.ValueString(), removed, false)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to resume channel rewards", err.Error())
+		return
+	}
+
+	// Pausing is idempotent, so rewards resumed outside Terraform are paused again too.
+	err = c.setPaused(plan.BroadcasterID.ValueString(), plan.RewardIDs, true)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to pause channel rewards", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *channelRewardPauseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state channelRewardPauseResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.setPaused(state.BroadcasterID.ValueString(), state.RewardIDs, false)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to resume channel rewards", err.Error())
+		return
+	}
+}
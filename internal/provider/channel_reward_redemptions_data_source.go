@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/ell/terraform-provider-twitch/internal/helix"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &channelRewardRedemptionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &channelRewardRedemptionsDataSource{}
+)
+
+type channelRewardRedemptionsDataSource struct {
+	client *helix.Client
+}
+
+type channelRewardRedemptionsDataSourceModel struct {
+	BroadcasterID types.String      `tfsdk:"broadcaster_id"`
+	RewardID      types.String      `tfsdk:"reward_id"`
+	Status        types.String      `tfsdk:"status"`
+	Redemptions   []redemptionModel `tfsdk:"redemptions"`
+}
+
+type redemptionModel struct {
+	ID         types.String `tfsdk:"id"`
+	UserID     types.String `tfsdk:"user_id"`
+	UserLogin  types.String `tfsdk:"user_login"`
+	UserName   types.String `tfsdk:"user_name"`
+	UserInput  types.String `tfsdk:"user_input"`
+	Status     types.String `tfsdk:"status"`
+	RedeemedAt types.String `tfsdk:"redeemed_at"`
+}
+
+func (c *channelRewardRedemptionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*helix.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected DataSource Configure Type", "Expected *helix.Client")
+
+		return
+	}
+
+	c.client = client
+}
+
+func (c *channelRewardRedemptionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_reward_redemptions"
+}
+
+func (c *channelRewardRedemptionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state channelRewardRedemptionsDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status := helix.RedemptionStatusUnfulfilled
+	if !state.Status.IsNull() {
+		status = state.Status.ValueString()
+	}
+
+	redemptions, err := c.client.GetRedemptions(state.BroadcasterID.ValueString(), state.RewardID.ValueString(), status)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get redemptions", err.Error())
+
+		return
+	}
+
+	state.Redemptions = []redemptionModel{}
+	for _, redemption := range redemptions {
+		state.Redemptions = append(state.Redemptions, redemptionModel{
+			ID:         types.StringValue(redemption.ID),
+			UserID:     types.StringValue(redemption.UserID),
+			UserLogin:  types.StringValue(redemption.UserLogin),
+			UserName:   types.StringValue(redemption.UserName),
+			UserInput:  types.StringValue(redemption.UserInput),
+			Status:     types.StringValue(redemption.Status),
+			RedeemedAt: types.StringValue(redemption.RedeemedAt),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *channelRewardRedemptionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists redemptions of a custom reward created by this client ID, oldest first.",
+		Attributes: map[string]schema.Attribute{
+			"broadcaster_id": schema.StringAttribute{
+				Required: true,
+			},
+			"reward_id": schema.StringAttribute{
+				Required: true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "`UNFULFILLED`, `FULFILLED` or `CANCELED`. Defaults to `UNFULFILLED`, the pending queue.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(helix.RedemptionStatusUnfulfilled, helix.RedemptionStatusFulfilled, helix.RedemptionStatusCanceled),
+				},
+			},
+			"redemptions": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"user_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"user_login": schema.StringAttribute{
+							Computed: true,
+						},
+						"user_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"user_input": schema.StringAttribute{
+							Computed: true,
+						},
+						"status": schema.StringAttribute{
+							Computed: true,
+						},
+						"redeemed_at": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func NewChannelRewardRedemptionsDataSource() datasource.DataSource {
+	return &channelRewardRedemptionsDataSource{}
+}
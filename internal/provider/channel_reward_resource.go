@@ -3,23 +3,61 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/ell/terraform-provider-twitch/internal/helix"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
-	_ resource.Resource                = &channelRewardResource{}
-	_ resource.ResourceWithConfigure   = &channelRewardResource{}
-	_ resource.ResourceWithImportState = &channelRewardResource{}
+	_ resource.Resource                   = &channelRewardResource{}
+	_ resource.ResourceWithConfigure      = &channelRewardResource{}
+	_ resource.ResourceWithImportState    = &channelRewardResource{}
+	_ resource.ResourceWithValidateConfig = &channelRewardResource{}
+	_ resource.ResourceWithModifyPlan     = &channelRewardResource{}
 )
 
+const (
+	// maxGlobalCooldownSeconds is the longest reward cooldown Twitch allows, 7 days.
+	maxGlobalCooldownSeconds = 604800
+
+	defaultRewardBackgroundColor = "#FFBF00"
+
+	defaultOnDestroyRedemptions = "keep"
+)
+
+// onDestroyRedemptionStatuses maps on_destroy_redemptions to the status
+// pending redemptions are set to before the reward is deleted.
+var onDestroyRedemptionStatuses = map[string]string{
+	"refund":  helix.RedemptionStatusCanceled,
+	"fulfill": helix.RedemptionStatusFulfilled,
+}
+
+// findRewardTitle returns the reward in rewards with title, compared case
+// insensitively like Twitch does, skipping the reward with ignoreID.
+func findRewardTitle(rewards []helix.ChannelReward, title, ignoreID string) *helix.ChannelReward {
+	for i := range rewards {
+		if rewards[i].ID != ignoreID && strings.EqualFold(rewards[i].Title, title) {
+			return &rewards[i]
+		}
+	}
+
+	return nil
+}
+
 type channelRewardResource struct {
 	TwitchClient *helix.Client
 }
@@ -43,28 +81,52 @@ func NewChannelRewardResource() resource.Resource {
 
 func (c *channelRewardResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a custom channel points reward. Reward titles must be unique per broadcaster. " +
+			"The Helix API cannot upload reward images, so custom images have to be set in the creator dashboard; " +
+			"their URLs are exposed read-only. Import with `broadcaster_id/id`.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"broadcaster_id": schema.StringAttribute{
 				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"title": schema.StringAttribute{
+				MarkdownDescription: "Unique per broadcaster. New and renamed titles are checked against the broadcaster's " +
+					"existing rewards at plan time; two new rewards with the same title are only caught by Twitch on apply, " +
+					"so use `twitch_channel_reward_set` to check a whole catalog up front.",
 				Required: true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthBetween(1, 45),
+				},
 			},
 			"prompt": schema.StringAttribute{
 				Optional: true,
 				Default:  stringdefault.StaticString(""),
 				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtMost(200),
+				},
 			},
 			"cost": schema.Int32Attribute{
 				Required: true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
 			},
 			"background_color": schema.StringAttribute{
 				Optional: true,
-				Default:  stringdefault.StaticString("#FFBF00"),
+				Default:  stringdefault.StaticString(defaultRewardBackgroundColor),
 				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(hexColorRegexp, "must be a #RRGGBB hex color"),
+				},
 			},
 			"is_global_cooldown_enabled": schema.BoolAttribute{
 				Optional: true,
@@ -72,13 +134,78 @@ func (c *channelRewardResource) Schema(_ context.Context, req resource.SchemaReq
 				Computed: true,
 			},
 			"global_cooldown_seconds": schema.Int32Attribute{
-				Optional: true,
-				Default:  int32default.StaticInt32(0),
-				Computed: true,
+				MarkdownDescription: "Cooldown between redemptions, up to 7 days. Requires `is_global_cooldown_enabled`.",
+				Optional:            true,
+				Default:             int32default.StaticInt32(0),
+				Computed:            true,
+				Validators: []validator.Int32{
+					int32validator.Between(0, maxGlobalCooldownSeconds),
+				},
 			},
 			"is_enabled": schema.BoolAttribute{
 				Required: true,
 			},
+			"is_paused": schema.BoolAttribute{
+				MarkdownDescription: "Whether redemptions are paused. A paused reward stays visible but cannot be redeemed. " +
+					"Leave unset when the reward is paused by `twitch_channel_reward_pause`.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"on_destroy_redemptions": schema.StringAttribute{
+				MarkdownDescription: "What to do with unfulfilled redemptions when the reward is destroyed: " +
+					"`refund` cancels them and returns the points, `fulfill` marks them fulfilled and `keep` leaves them. Defaults to `keep`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(defaultOnDestroyRedemptions),
+				Validators: []validator.String{
+					stringvalidator.OneOf("refund", "fulfill", "keep"),
+				},
+			},
+			"image_url_1x": schema.StringAttribute{
+				MarkdownDescription: "Custom image URL at 1x, or null if the reward has no custom image.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"image_url_2x": schema.StringAttribute{
+				MarkdownDescription: "Custom image URL at 2x, or null if the reward has no custom image.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"image_url_4x": schema.StringAttribute{
+				MarkdownDescription: "Custom image URL at 4x, or null if the reward has no custom image.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_image_url_1x": schema.StringAttribute{
+				MarkdownDescription: "Default image URL at 1x.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_image_url_2x": schema.StringAttribute{
+				MarkdownDescription: "Default image URL at 2x.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_image_url_4x": schema.StringAttribute{
+				MarkdownDescription: "Default image URL at 4x.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
@@ -93,6 +220,129 @@ type channelRewardResourceModel struct {
 	IsGlobalCooldownEnabled types.Bool   `tfsdk:"is_global_cooldown_enabled"`
 	GlobalCooldownSeconds   types.Int32  `tfsdk:"global_cooldown_seconds"`
 	IsEnabled               types.Bool   `tfsdk:"is_enabled"`
+	IsPaused                types.Bool   `tfsdk:"is_paused"`
+	OnDestroyRedemptions    types.String `tfsdk:"on_destroy_redemptions"`
+	ImageURL1x              types.String `tfsdk:"image_url_1x"`
+	ImageURL2x              types.String `tfsdk:"image_url_2x"`
+	ImageURL4x              types.String `tfsdk:"image_url_4x"`
+	DefaultImageURL1x       types.String `tfsdk:"default_image_url_1x"`
+	DefaultImageURL2x       types.String `tfsdk:"default_image_url_2x"`
+	DefaultImageURL4x       types.String `tfsdk:"default_image_url_4x"`
+}
+
+func (m *channelRewardResourceModel) setImages(reward *helix.ChannelReward) {
+	m.ImageURL1x = types.StringNull()
+	m.ImageURL2x = types.StringNull()
+	m.ImageURL4x = types.StringNull()
+
+	if reward.Image != nil {
+		m.ImageURL1x = types.StringValue(reward.Image.URL1x)
+		m.ImageURL2x = types.StringValue(reward.Image.URL2x)
+		m.ImageURL4x = types.StringValue(reward.Image.URL4x)
+	}
+
+	m.DefaultImageURL1x = types.StringValue(reward.DefaultImage.URL1x)
+	m.DefaultImageURL2x = types.StringValue(reward.DefaultImage.URL2x)
+	m.DefaultImageURL4x = types.StringValue(reward.DefaultImage.URL4x)
+}
+
+func (c *channelRewardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config channelRewardResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.IsGlobalCooldownEnabled.IsUnknown() || config.GlobalCooldownSeconds.IsUnknown() {
+		return
+	}
+
+	err := checkRewardCooldown(config.IsGlobalCooldownEnabled.ValueBool(), config.GlobalCooldownSeconds.ValueInt32())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("global_cooldown_seconds"), "Invalid global cooldown", err.Error())
+	}
+}
+
+// checkRewardCooldown enforces that a cooldown is set exactly when it is
+// enabled.
+func checkRewardCooldown(enabled bool, seconds int32) error {
+	if seconds > 0 && !enabled {
+		return fmt.Errorf("global_cooldown_seconds requires is_global_cooldown_enabled to be true")
+	}
+
+	if enabled && seconds < 1 {
+		return fmt.Errorf("global_cooldown_seconds must be at least 1 when is_global_cooldown_enabled is true")
+	}
+
+	return nil
+}
+
+// ModifyPlan checks new and renamed rewards against the broadcaster's other
+// rewards, since Twitch refuses duplicate titles on apply. A clash with a
+// reward this client cannot manage always fails, so it is an error. A clash
+// with a manageable reward is only a warning: that reward may be renamed,
+// replaced or destroyed earlier in the same apply, as when two rewards swap
+// titles or a tainted reward is replaced.
+func (c *channelRewardResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || c.TwitchClient == nil {
+		return
+	}
+
+	var plan, state channelRewardResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if plan.BroadcasterId.IsUnknown() || plan.Title.IsUnknown() {
+		return
+	}
+
+	if plan.BroadcasterId.Equal(state.BroadcasterId) && strings.EqualFold(plan.Title.ValueString(), state.Title.ValueString()) {
+		return
+	}
+
+	broadcasterID := plan.BroadcasterId.ValueString()
+
+	rewards, err := c.TwitchClient.GetChannelRewards(broadcasterID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get channel rewards", err.Error())
+		return
+	}
+
+	existing := findRewardTitle(*rewards, plan.Title.ValueString(), state.ID.ValueString())
+	if existing == nil {
+		return
+	}
+
+	manageable, err := c.TwitchClient.GetManageableChannelRewards(broadcasterID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get channel rewards", err.Error())
+		return
+	}
+
+	if slices.ContainsFunc(manageable, func(reward helix.ChannelReward) bool { return reward.ID == existing.ID }) {
+		resp.Diagnostics.AddAttributeWarning(path.Root("title"), "Duplicate reward title",
+			fmt.Sprintf("Reward %s of broadcaster %s already has the title %q. The apply fails unless that reward is renamed or destroyed first.",
+				existing.ID, broadcasterID, existing.Title))
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(path.Root("title"), "Duplicate reward title",
+		fmt.Sprintf("Reward %s of broadcaster %s already has the title %q and was not created by this client ID, so Terraform cannot rename it. "+
+			"Reward titles must be unique per broadcaster.", existing.ID, broadcasterID, existing.Title))
 }
 
 func (c *channelRewardResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -123,7 +373,18 @@ func (c *channelRewardResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	// Rewards cannot be created paused, so pause them right after.
+	if plan.IsPaused.ValueBool() {
+		reward, err = c.TwitchClient.SetChannelRewardPaused(plan.BroadcasterId.ValueString(), reward.ID, true)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to pause channel reward", err.Error())
+			return
+		}
+	}
+
 	plan.ID = types.StringValue(reward.ID)
+	plan.IsPaused = types.BoolValue(reward.IsPaused)
+	plan.setImages(reward)
 
 	diags = resp.State.Set(ctx, &plan)
 
@@ -149,6 +410,11 @@ func (c *channelRewardResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	if reward == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	state.ID = types.StringValue(reward.ID)
 	state.BroadcasterId = types.StringValue(reward.BroadcasterID)
 	state.Title = types.StringValue(reward.Title)
@@ -157,6 +423,14 @@ func (c *channelRewardResource) Read(ctx context.Context, req resource.ReadReque
 	state.BackgroundColor = types.StringValue(reward.BackgroundColor)
 	state.IsGlobalCooldownEnabled = types.BoolValue(reward.GlobalCooldownSetting.IsEnabled)
 	state.GlobalCooldownSeconds = types.Int32Value(int32(reward.GlobalCooldownSetting.GlobalCooldownSeconds))
+	state.IsEnabled = types.BoolValue(reward.IsEnabled)
+	state.IsPaused = types.BoolValue(reward.IsPaused)
+	state.setImages(reward)
+
+	// Imported rewards have no on_destroy_redemptions yet.
+	if state.OnDestroyRedemptions.IsNull() {
+		state.OnDestroyRedemptions = types.StringValue(defaultOnDestroyRedemptions)
+	}
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -168,7 +442,6 @@ func (c *channelRewardResource) Read(ctx context.Context, req resource.ReadReque
 
 func (c *channelRewardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan channelRewardResourceModel
-	var state channelRewardResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -176,24 +449,17 @@ func (c *channelRewardResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	diags = req.State.Get(ctx, &state)
+	// is_paused is only sent when configured, so a twitch_channel_reward_pause
+	// can pause the reward without this resource undoing it.
+	var configuredPaused types.Bool
+
+	diags = req.Config.GetAttribute(ctx, path.Root("is_paused"), &configuredPaused)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	rewardID := plan.ID.ValueString()
-	if plan.ID.IsUnknown() {
-		reward, err := c.TwitchClient.GetChannelRewardByName(state.BroadcasterId.ValueString(), state.Title.ValueString())
-		if err != nil {
-			resp.Diagnostics.AddError("Failed to get channel reward by name", err.Error())
-			return
-		}
-
-		rewardID = reward.ID
-	}
-
-	updatedReward, err := c.TwitchClient.UpdateChannelReward(plan.BroadcasterId.ValueString(), rewardID, &helix.UpdateChannelRewardRequest{
+	updateRequest := &helix.UpdateChannelRewardRequest{
 		Title:                   plan.Title.ValueString(),
 		Prompt:                  plan.Prompt.ValueString(),
 		Cost:                    int(plan.Cost.ValueInt32()),
@@ -201,7 +467,14 @@ func (c *channelRewardResource) Update(ctx context.Context, req resource.UpdateR
 		IsGlobalCooldownEnabled: plan.IsGlobalCooldownEnabled.ValueBool(),
 		GlobalCooldownSeconds:   int(plan.GlobalCooldownSeconds.ValueInt32()),
 		IsEnabled:               plan.IsEnabled.ValueBool(),
-	})
+	}
+
+	if !configuredPaused.IsNull() {
+		isPaused := configuredPaused.ValueBool()
+		updateRequest.IsPaused = &isPaused
+	}
+
+	updatedReward, err := c.TwitchClient.UpdateChannelReward(plan.BroadcasterId.ValueString(), plan.ID.ValueString(), updateRequest)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to update channel reward id "+plan.ID.ValueString(), err.Error())
@@ -216,6 +489,11 @@ func (c *channelRewardResource) Update(ctx context.Context, req resource.UpdateR
 	plan.Cost = types.Int32Value(int32(updatedReward.Cost))
 	plan.Prompt = types.StringValue(updatedReward.Prompt)
 	plan.Title = types.StringValue(updatedReward.Title)
+	plan.setImages(updatedReward)
+
+	if !configuredPaused.IsNull() {
+		plan.IsPaused = types.BoolValue(updatedReward.IsPaused)
+	}
 
 	diags = resp.State.Set(ctx, &plan)
 
@@ -235,6 +513,25 @@ func (c *channelRewardResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	if status, ok := onDestroyRedemptionStatuses[state.OnDestroyRedemptions.ValueString()]; ok {
+		redemptions, err := c.TwitchClient.GetRedemptions(state.BroadcasterId.ValueString(), state.ID.ValueString(), helix.RedemptionStatusUnfulfilled)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to get pending redemptions", err.Error())
+			return
+		}
+
+		redemptionIDs := []string{}
+		for _, redemption := range redemptions {
+			redemptionIDs = append(redemptionIDs, redemption.ID)
+		}
+
+		err = c.TwitchClient.UpdateRedemptionStatus(state.BroadcasterId.ValueString(), state.ID.ValueString(), redemptionIDs, status)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to update pending redemptions", err.Error())
+			return
+		}
+	}
+
 	err := c.TwitchClient.DeleteChannelReward(state.BroadcasterId.ValueString(), state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to delete channel reward", err.Error())
@@ -243,5 +540,5 @@ func (c *channelRewardResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (c *channelRewardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateParts(ctx, req, resp, "broadcaster_id", "id")
 }
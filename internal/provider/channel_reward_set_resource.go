@@ -0,0 +1,718 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ell/terraform-provider-twitch/internal/helix"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                     = &channelRewardSetResource{}
+	_ resource.ResourceWithConfigure        = &channelRewardSetResource{}
+	_ resource.ResourceWithConfigValidators = &channelRewardSetResource{}
+	_ resource.ResourceWithModifyPlan       = &channelRewardSetResource{}
+	_ resource.ResourceWithImportState      = &channelRewardSetResource{}
+)
+
+type channelRewardSetResource struct {
+	TwitchClient *helix.Client
+}
+
+func (c *channelRewardSetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	twitchClient, ok := req.ProviderData.(*helix.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *helix.Client, got %T", req.ProviderData))
+
+		return
+	}
+
+	c.TwitchClient = twitchClient
+}
+
+func NewChannelRewardSetResource() resource.Resource {
+	return &channelRewardSetResource{}
+}
+
+func (c *channelRewardSetResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the full set of custom rewards this client ID can manage for a broadcaster. " +
+			"Rewards are created, updated, paused or deleted to match `rewards` or `catalog_file`, and " +
+			"manageable rewards missing from them are deleted, so do not combine it with `twitch_channel_reward` " +
+			"for the same broadcaster. Rewards without `is_paused` can be paused by `twitch_channel_reward_pause`. " +
+			"Import with the broadcaster ID.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"broadcaster_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"catalog_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a YAML or JSON file with a top-level `rewards` map, keyed like `rewards`. " +
+					"The file is read on every plan.",
+				Optional: true,
+			},
+			"rewards": schema.MapNestedAttribute{
+				MarkdownDescription: "Rewards keyed by a name of your choice. Computed from `catalog_file` when that is set.",
+				Optional:            true,
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"title": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.UTF8LengthBetween(1, 45),
+							},
+						},
+						"cost": schema.Int32Attribute{
+							Required: true,
+							Validators: []validator.Int32{
+								int32validator.AtLeast(1),
+							},
+						},
+						"prompt": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString(""),
+							Validators: []validator.String{
+								stringvalidator.UTF8LengthAtMost(200),
+							},
+						},
+						"background_color": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString(defaultRewardBackgroundColor),
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(hexColorRegexp, "must be a #RRGGBB hex color"),
+							},
+						},
+						"is_enabled": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(true),
+						},
+						"is_paused": schema.BoolAttribute{
+							MarkdownDescription: "Whether redemptions are paused. Only sent to Twitch when set, so leave it " +
+								"unset for rewards paused by `twitch_channel_reward_pause`.",
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"is_global_cooldown_enabled": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+						"global_cooldown_seconds": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+							Default:  int32default.StaticInt32(0),
+							Validators: []validator.Int32{
+								int32validator.Between(0, maxGlobalCooldownSeconds),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (c *channelRewardSetResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("rewards"),
+			path.MatchRoot("catalog_file"),
+		),
+	}
+}
+
+type channelRewardSetResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	BroadcasterID types.String `tfsdk:"broadcaster_id"`
+	CatalogFile   types.String `tfsdk:"catalog_file"`
+	Rewards       types.Map    `tfsdk:"rewards"`
+}
+
+type channelRewardSetItemModel struct {
+	ID                      types.String `tfsdk:"id"`
+	Title                   types.String `tfsdk:"title"`
+	Cost                    types.Int32  `tfsdk:"cost"`
+	Prompt                  types.String `tfsdk:"prompt"`
+	BackgroundColor         types.String `tfsdk:"background_color"`
+	IsEnabled               types.Bool   `tfsdk:"is_enabled"`
+	IsPaused                types.Bool   `tfsdk:"is_paused"`
+	IsGlobalCooldownEnabled types.Bool   `tfsdk:"is_global_cooldown_enabled"`
+	GlobalCooldownSeconds   types.Int32  `tfsdk:"global_cooldown_seconds"`
+}
+
+var channelRewardSetItemType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":                         types.StringType,
+		"title":                      types.StringType,
+		"cost":                       types.Int32Type,
+		"prompt":                     types.StringType,
+		"background_color":           types.StringType,
+		"is_enabled":                 types.BoolType,
+		"is_paused":                  types.BoolType,
+		"is_global_cooldown_enabled": types.BoolType,
+		"global_cooldown_seconds":    types.Int32Type,
+	},
+}
+
+func newChannelRewardSetItem(reward *helix.ChannelReward) channelRewardSetItemModel {
+	return channelRewardSetItemModel{
+		ID:                      types.StringValue(reward.ID),
+		Title:                   types.StringValue(reward.Title),
+		Cost:                    types.Int32Value(int32(reward.Cost)),
+		Prompt:                  types.StringValue(reward.Prompt),
+		BackgroundColor:         types.StringValue(reward.BackgroundColor),
+		IsEnabled:               types.BoolValue(reward.IsEnabled),
+		IsPaused:                types.BoolValue(reward.IsPaused),
+		IsGlobalCooldownEnabled: types.BoolValue(reward.GlobalCooldownSetting.IsEnabled),
+		GlobalCooldownSeconds:   types.Int32Value(int32(reward.GlobalCooldownSetting.GlobalCooldownSeconds)),
+	}
+}
+
+// matches reports whether reward already has the settings of the item. An
+// unknown is_paused matches any reward.
+func (m channelRewardSetItemModel) matches(reward *helix.ChannelReward) bool {
+	isPaused := m.IsPaused
+	if isPaused.IsUnknown() {
+		isPaused = types.BoolValue(reward.IsPaused)
+	}
+
+	return newChannelRewardSetItem(reward) == channelRewardSetItemModel{
+		ID:                      types.StringValue(reward.ID),
+		Title:                   m.Title,
+		Cost:                    m.Cost,
+		Prompt:                  m.Prompt,
+		BackgroundColor:         m.BackgroundColor,
+		IsEnabled:               m.IsEnabled,
+		IsPaused:                isPaused,
+		IsGlobalCooldownEnabled: m.IsGlobalCooldownEnabled,
+		GlobalCooldownSeconds:   m.GlobalCooldownSeconds,
+	}
+}
+
+// updateRequest leaves is_paused out when it is unknown, so the reward keeps
+// whatever pause state it has on Twitch.
+func (m channelRewardSetItemModel) updateRequest() *helix.UpdateChannelRewardRequest {
+	updateRequest := &helix.UpdateChannelRewardRequest{
+		Title:                   m.Title.ValueString(),
+		Prompt:                  m.Prompt.ValueString(),
+		Cost:                    int(m.Cost.ValueInt32()),
+		BackgroundColor:         m.BackgroundColor.ValueString(),
+		IsGlobalCooldownEnabled: m.IsGlobalCooldownEnabled.ValueBool(),
+		GlobalCooldownSeconds:   int(m.GlobalCooldownSeconds.ValueInt32()),
+		IsEnabled:               m.IsEnabled.ValueBool(),
+	}
+
+	if !m.IsPaused.IsUnknown() {
+		isPaused := m.IsPaused.ValueBool()
+		updateRequest.IsPaused = &isPaused
+	}
+
+	return updateRequest
+}
+
+func (m *channelRewardSetResourceModel) rewards(ctx context.Context) (map[string]channelRewardSetItemModel, diag.Diagnostics) {
+	rewards := map[string]channelRewardSetItemModel{}
+	if m.Rewards.IsNull() || m.Rewards.IsUnknown() {
+		return rewards, nil
+	}
+
+	diags := m.Rewards.ElementsAs(ctx, &rewards, false)
+
+	return rewards, diags
+}
+
+func (m *channelRewardSetResourceModel) setRewards(ctx context.Context, rewards map[string]channelRewardSetItemModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Rewards, diags = types.MapValueFrom(ctx, channelRewardSetItemType, rewards)
+
+	return diags
+}
+
+func sortedRewardKeys(rewards map[string]channelRewardSetItemModel) []string {
+	keys := make([]string, 0, len(rewards))
+	for key := range rewards {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func (c *channelRewardSetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_reward_set"
+}
+
+// ModifyPlan loads catalog_file into rewards, so the plan shows a diff per
+// reward, and rejects titles used by more than one reward in the set.
+func (c *channelRewardSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan channelRewardSetResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.CatalogFile.IsNull() && !plan.CatalogFile.IsUnknown() {
+		rewards, err := loadRewardCatalog(plan.CatalogFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("catalog_file"), "Failed to load reward catalog", err.Error())
+			return
+		}
+
+		for _, err := range checkRewardCatalog(rewards) {
+			resp.Diagnostics.AddAttributeError(path.Root("catalog_file"), "Invalid reward in catalog", err.Error())
+		}
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		stateRewards := map[string]channelRewardSetItemModel{}
+		if !req.State.Raw.IsNull() {
+			var state channelRewardSetResourceModel
+
+			diags = req.State.Get(ctx, &state)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			stateRewards, diags = state.rewards(ctx)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		// Catalog rewards without is_paused keep their pause state, like an
+		// unset is_paused in rewards does.
+		for key, item := range rewards {
+			stateItem, ok := stateRewards[key]
+			if ok {
+				item.ID = stateItem.ID
+			}
+
+			if item.IsPaused.IsNull() {
+				item.IsPaused = types.BoolUnknown()
+				if ok {
+					item.IsPaused = stateItem.IsPaused
+				}
+			}
+
+			rewards[key] = item
+		}
+
+		diags = plan.setRewards(ctx, rewards)
+		resp.Diagnostics.Append(diags...)
+
+		diags = resp.Plan.Set(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	rewards, diags := plan.rewards(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	titles := map[string]string{}
+	for _, key := range sortedRewardKeys(rewards) {
+		title := rewards[key].Title
+		if title.IsUnknown() {
+			continue
+		}
+
+		folded := strings.ToLower(title.ValueString())
+		if existing, ok := titles[folded]; ok {
+			resp.Diagnostics.AddAttributeError(path.Root("rewards").AtMapKey(key).AtName("title"), "Duplicate reward title",
+				fmt.Sprintf("Reward %q already has the title %q. Reward titles must be unique per broadcaster.", existing, title.ValueString()))
+			continue
+		}
+
+		titles[folded] = key
+	}
+}
+
+// configuredPauses returns the keys of the rewards whose is_paused is set in
+// the configuration or catalog file.
+func configuredPauses(ctx context.Context, config tfsdk.Config) (map[string]bool, diag.Diagnostics) {
+	var model channelRewardSetResourceModel
+
+	diags := config.Get(ctx, &model)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var rewards map[string]channelRewardSetItemModel
+
+	if model.CatalogFile.IsNull() {
+		rewards, diags = model.rewards(ctx)
+		if diags.HasError() {
+			return nil, diags
+		}
+	} else {
+		var err error
+
+		rewards, err = loadRewardCatalog(model.CatalogFile.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("catalog_file"), "Failed to load reward catalog", err.Error())
+			return nil, diags
+		}
+	}
+
+	configured := map[string]bool{}
+	for key, item := range rewards {
+		if !item.IsPaused.IsNull() {
+			configured[key] = true
+		}
+	}
+
+	return configured, diags
+}
+
+// reconcile makes the manageable rewards of the broadcaster match rewards,
+// and returns rewards with their IDs filled in. Rewards are matched by ID
+// first, then adopted by title; unmatched manageable rewards are deleted
+// before anything is created, to free their titles. is_paused is only sent
+// for the rewards in pauses, so twitch_channel_reward_pause is not undone.
+func (c *channelRewardSetResource) reconcile(broadcasterID string, rewards map[string]channelRewardSetItemModel, pauses map[string]bool) error {
+	existing, err := c.TwitchClient.GetManageableChannelRewards(broadcasterID)
+	if err != nil {
+		return err
+	}
+
+	byID := map[string]*helix.ChannelReward{}
+	byTitle := map[string]*helix.ChannelReward{}
+
+	for i := range existing {
+		byID[existing[i].ID] = &existing[i]
+		byTitle[strings.ToLower(existing[i].Title)] = &existing[i]
+	}
+
+	keys := sortedRewardKeys(rewards)
+	matched := map[string]*helix.ChannelReward{}
+	claimed := map[string]bool{}
+
+	for _, key := range keys {
+		id := rewards[key].ID
+		if id.IsNull() || id.IsUnknown() {
+			continue
+		}
+
+		if reward, ok := byID[id.ValueString()]; ok {
+			matched[key] = reward
+			claimed[reward.ID] = true
+		}
+	}
+
+	for _, key := range keys {
+		if _, ok := matched[key]; ok {
+			continue
+		}
+
+		reward, ok := byTitle[strings.ToLower(rewards[key].Title.ValueString())]
+		if ok && !claimed[reward.ID] {
+			matched[key] = reward
+			claimed[reward.ID] = true
+		}
+	}
+
+	for _, reward := range existing {
+		if claimed[reward.ID] {
+			continue
+		}
+
+		err = c.TwitchClient.DeleteChannelReward(broadcasterID, reward.ID)
+		if err != nil {
+			return fmt.Errorf("deleting reward %q: %w", reward.Title, err)
+		}
+	}
+
+	// Twitch refuses duplicate titles, so a reward whose title another reward
+	// takes over, as when two rewards swap titles, is moved out of the way
+	// first under a temporary title.
+	wanted := map[string]bool{}
+	for _, item := range rewards {
+		wanted[strings.ToLower(item.Title.ValueString())] = true
+	}
+
+	for _, key := range keys {
+		reward, ok := matched[key]
+		if !ok || strings.EqualFold(reward.Title, rewards[key].Title.ValueString()) || !wanted[strings.ToLower(reward.Title)] {
+			continue
+		}
+
+		temporary := newChannelRewardSetItem(reward)
+		temporary.Title = types.StringValue("~" + reward.ID)
+		temporary.IsPaused = types.BoolUnknown()
+
+		matched[key], err = c.TwitchClient.UpdateChannelReward(broadcasterID, reward.ID, temporary.updateRequest())
+		if err != nil {
+			return fmt.Errorf("renaming reward %q: %w", key, err)
+		}
+	}
+
+	for _, key := range keys {
+		item := rewards[key]
+
+		reward, ok := matched[key]
+		if !ok {
+			reward, err = c.TwitchClient.CreateChannelReward(broadcasterID, &helix.CreateChannelRewardRequest{
+				Title:                   item.Title.ValueString(),
+				Prompt:                  item.Prompt.ValueString(),
+				Cost:                    int(item.Cost.ValueInt32()),
+				BackgroundColor:         item.BackgroundColor.ValueString(),
+				IsGlobalCooldownEnabled: item.IsGlobalCooldownEnabled.ValueBool(),
+				GlobalCooldownSeconds:   int(item.GlobalCooldownSeconds.ValueInt32()),
+				IsEnabled:               item.IsEnabled.ValueBool(),
+			})
+			if err != nil {
+				return fmt.Errorf("creating reward %q: %w", key, err)
+			}
+		}
+
+		desired := item
+		if !pauses[key] {
+			desired.IsPaused = types.BoolUnknown()
+		}
+
+		// Rewards cannot be created paused, so new paused rewards are updated too.
+		if !desired.matches(reward) {
+			reward, err = c.TwitchClient.UpdateChannelReward(broadcasterID, reward.ID, desired.updateRequest())
+			if err != nil {
+				return fmt.Errorf("updating reward %q: %w", key, err)
+			}
+		}
+
+		item.ID = types.StringValue(reward.ID)
+		if item.IsPaused.IsUnknown() {
+			item.IsPaused = types.BoolValue(reward.IsPaused)
+		}
+
+		rewards[key] = item
+	}
+
+	return nil
+}
+
+func (c *channelRewardSetResource) apply(ctx context.Context, plan *channelRewardSetResourceModel, config tfsdk.Config) diag.Diagnostics {
+	rewards, diags := plan.rewards(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	pauses, pauseDiags := configuredPauses(ctx, config)
+	diags.Append(pauseDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	err := c.reconcile(plan.BroadcasterID.ValueString(), rewards, pauses)
+	if err != nil {
+		diags.AddError("Failed to reconcile channel rewards", err.Error())
+		return diags
+	}
+
+	plan.ID = plan.BroadcasterID
+	diags.Append(plan.setRewards(ctx, rewards)...)
+
+	return diags
+}
+
+func (c *channelRewardSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan channelRewardSetResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = c.apply(ctx, &plan, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the rewards in state and adds manageable rewards created
+// outside Terraform, keyed by title, so the next plan deletes them.
+func (c *channelRewardSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state channelRewardSetResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rewards, diags := state.rewards(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, err := c.TwitchClient.GetManageableChannelRewards(state.BroadcasterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get channel rewards", err.Error())
+		return
+	}
+
+	byID := map[string]*helix.ChannelReward{}
+	for i := range existing {
+		byID[existing[i].ID] = &existing[i]
+	}
+
+	refreshed := map[string]channelRewardSetItemModel{}
+	for key, item := range rewards {
+		reward, ok := byID[item.ID.ValueString()]
+		if !ok {
+			continue
+		}
+
+		refreshed[key] = newChannelRewardSetItem(reward)
+		delete(byID, reward.ID)
+	}
+
+	for _, reward := range existing {
+		if _, ok := byID[reward.ID]; !ok {
+			continue
+		}
+
+		key := reward.Title
+		if _, taken := refreshed[key]; taken {
+			key = reward.Title + " (" + reward.ID + ")"
+		}
+
+		refreshed[key] = newChannelRewardSetItem(&reward)
+	}
+
+	state.ID = state.BroadcasterID
+
+	diags = state.setRewards(ctx, refreshed)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *channelRewardSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan channelRewardSetResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = c.apply(ctx, &plan, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *channelRewardSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state channelRewardSetResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rewards, diags := state.rewards(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, err := c.TwitchClient.GetManageableChannelRewards(state.BroadcasterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get channel rewards", err.Error())
+		return
+	}
+
+	managed := map[string]bool{}
+	for _, item := range rewards {
+		managed[item.ID.ValueString()] = true
+	}
+
+	// Only delete rewards that still exist, since deleting a missing reward fails.
+	for _, reward := range existing {
+		if !managed[reward.ID] {
+			continue
+		}
+
+		err = c.TwitchClient.DeleteChannelReward(state.BroadcasterID.ValueString(), reward.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to delete channel reward "+reward.Title, err.Error())
+			return
+		}
+	}
+}
+
+func (c *channelRewardSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("broadcaster_id"), req, resp)
+}
@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/ell/terraform-provider-twitch/internal/helix"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &channelScheduleDataSource{}
+	_ datasource.DataSourceWithConfigure = &channelScheduleDataSource{}
+)
+
+const defaultScheduleLimit = 25
+
+type channelScheduleDataSource struct {
+	client *helix.Client
+}
+
+type channelScheduleDataSourceModel struct {
+	BroadcasterID     types.String           `tfsdk:"broadcaster_id"`
+	StartTime         types.String           `tfsdk:"start_time"`
+	Limit             types.Int32            `tfsdk:"limit"`
+	IncludeICalendar  types.Bool             `tfsdk:"include_icalendar"`
+	Segments          []scheduleSegmentModel `tfsdk:"segments"`
+	VacationStartTime types.String           `tfsdk:"vacation_start_time"`
+	VacationEndTime   types.String           `tfsdk:"vacation_end_time"`
+	ICalendar         types.String           `tfsdk:"icalendar"`
+}
+
+type scheduleSegmentModel struct {
+	ID            types.String `tfsdk:"id"`
+	StartTime     types.String `tfsdk:"start_time"`
+	EndTime       types.String `tfsdk:"end_time"`
+	Title         types.String `tfsdk:"title"`
+	CanceledUntil types.String `tfsdk:"canceled_until"`
+	CategoryID    types.String `tfsdk:"category_id"`
+	CategoryName  types.String `tfsdk:"category_name"`
+	IsRecurring   types.Bool   `tfsdk:"is_recurring"`
+}
+
+func (c *channelScheduleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*helix.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected DataSource Configure Type", "Expected *helix.Client")
+
+		return
+	}
+
+	c.client = client
+}
+
+func (c *channelScheduleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_schedule"
+}
+
+func (c *channelScheduleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state channelScheduleDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit := defaultScheduleLimit
+	if !state.Limit.IsNull() {
+		limit = int(state.Limit.ValueInt32())
+	}
+
+	schedule, err := c.client.GetSchedule(state.BroadcasterID.ValueString(), state.StartTime.ValueString(), limit)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get schedule", err.Error())
+
+		return
+	}
+
+	state.Segments = []scheduleSegmentModel{}
+	state.VacationStartTime = types.StringNull()
+	state.VacationEndTime = types.StringNull()
+
+	if schedule != nil {
+		for _, segment := range schedule.Segments {
+			segmentModel := scheduleSegmentModel{
+				ID:            types.StringValue(segment.ID),
+				StartTime:     types.StringValue(segment.StartTime),
+				EndTime:       types.StringValue(segment.EndTime),
+				Title:         types.StringValue(segment.Title),
+				CanceledUntil: types.StringValue(segment.CanceledUntil),
+				CategoryID:    types.StringNull(),
+				CategoryName:  types.StringNull(),
+				IsRecurring:   types.BoolValue(segment.IsRecurring),
+			}
+
+			if segment.Category != nil {
+				segmentModel.CategoryID = types.StringValue(segment.Category.ID)
+				segmentModel.CategoryName = types.StringValue(segment.Category.Name)
+			}
+
+			state.Segments = append(state.Segments, segmentModel)
+		}
+
+		if schedule.Vacation != nil {
+			state.VacationStartTime = types.StringValue(schedule.Vacation.StartTime)
+			state.VacationEndTime = types.StringValue(schedule.Vacation.EndTime)
+		}
+	}
+
+	state.ICalendar = types.StringNull()
+	if state.IncludeICalendar.ValueBool() {
+		calendar, err := c.client.GetScheduleICalendar(state.BroadcasterID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to get schedule iCalendar", err.Error())
+
+			return
+		}
+
+		state.ICalendar = types.StringValue(calendar)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *channelScheduleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"broadcaster_id": schema.StringAttribute{
+				Required: true,
+			},
+			"start_time": schema.StringAttribute{
+				MarkdownDescription: "RFC3339 time to list segments from. Defaults to now.",
+				Optional:            true,
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+			"limit": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of segments to return. Defaults to 25.",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 500),
+				},
+			},
+			"include_icalendar": schema.BoolAttribute{
+				MarkdownDescription: "Also export the schedule as iCalendar text in `icalendar`.",
+				Optional:            true,
+			},
+			"segments": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"start_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"end_time": schema.StringAttribute{
+							Computed: true,
+						},
+						"title": schema.StringAttribute{
+							Computed: true,
+						},
+						"canceled_until": schema.StringAttribute{
+							Computed: true,
+						},
+						"category_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"category_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"is_recurring": schema.BoolAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"vacation_start_time": schema.StringAttribute{
+				Computed: true,
+			},
+			"vacation_end_time": schema.StringAttribute{
+				Computed: true,
+			},
+			"icalendar": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func NewChannelScheduleDataSource() datasource.DataSource {
+	return &channelScheduleDataSource{}
+}
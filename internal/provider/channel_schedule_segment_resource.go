@@ -0,0 +1,306 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ell/terraform-provider-twitch/internal/helix"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &channelScheduleSegmentResource{}
+	_ resource.ResourceWithConfigure   = &channelScheduleSegmentResource{}
+	_ resource.ResourceWithImportState = &channelScheduleSegmentResource{}
+)
+
+type channelScheduleSegmentResource struct {
+	TwitchClient *helix.Client
+}
+
+func (c *channelScheduleSegmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	twitchClient, ok := req.ProviderData.(*helix.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *helix.Client, got %T", req.ProviderData))
+
+		return
+	}
+
+	c.TwitchClient = twitchClient
+}
+
+func NewChannelScheduleSegmentResource() resource.Resource {
+	return &channelScheduleSegmentResource{}
+}
+
+func (c *channelScheduleSegmentResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a segment of a broadcaster's stream schedule. Import with `broadcaster_id/id`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"broadcaster_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"start_time": schema.StringAttribute{
+				MarkdownDescription: "RFC3339 start of the broadcast. For recurring segments, any occurrence of the weekly slot.",
+				Required:            true,
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+			"timezone": schema.StringAttribute{
+				MarkdownDescription: "IANA time zone the broadcast is scheduled in, used to keep recurring segments on the same local time.",
+				Required:            true,
+				Validators: []validator.String{
+					timezoneValidator{},
+				},
+			},
+			"duration": schema.Int32Attribute{
+				MarkdownDescription: "Length of the broadcast in minutes.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int32default.StaticInt32(240),
+				Validators: []validator.Int32{
+					int32validator.Between(30, 1380),
+				},
+			},
+			"is_recurring": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"category_id": schema.StringAttribute{
+				MarkdownDescription: "Game or category ID, for example `data.twitch_game.example.id`. " +
+					"When omitted, the category set on Twitch is kept; set it to `\"\"` to clear it.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"category_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"title": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(140),
+				},
+			},
+			"end_time": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+type channelScheduleSegmentResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	BroadcasterID types.String `tfsdk:"broadcaster_id"`
+	StartTime     types.String `tfsdk:"start_time"`
+	Timezone      types.String `tfsdk:"timezone"`
+	Duration      types.Int32  `tfsdk:"duration"`
+	IsRecurring   types.Bool   `tfsdk:"is_recurring"`
+	CategoryID    types.String `tfsdk:"category_id"`
+	CategoryName  types.String `tfsdk:"category_name"`
+	Title         types.String `tfsdk:"title"`
+	EndTime       types.String `tfsdk:"end_time"`
+}
+
+// setSegment copies a segment into the model. The configured start_time is
+// kept when it describes the same slot, since Twitch normalizes it to UTC and,
+// for recurring segments, to the next occurrence.
+func (m *channelScheduleSegmentResourceModel) setSegment(segment *helix.ScheduleSegment) {
+	m.ID = types.StringValue(segment.ID)
+	m.IsRecurring = types.BoolValue(segment.IsRecurring)
+	m.Title = types.StringValue(segment.Title)
+	m.EndTime = types.StringValue(segment.EndTime)
+
+	if !sameScheduleSlot(m.StartTime.ValueString(), segment.StartTime, m.Timezone.ValueString(), segment.IsRecurring) {
+		m.StartTime = types.StringValue(segment.StartTime)
+	}
+
+	start, startErr := time.Parse(time.RFC3339, segment.StartTime)
+	end, endErr := time.Parse(time.RFC3339, segment.EndTime)
+	if startErr == nil && endErr == nil {
+		m.Duration = types.Int32Value(int32(end.Sub(start).Minutes()))
+	}
+
+	m.CategoryID = types.StringValue("")
+	m.CategoryName = types.StringValue("")
+	if segment.Category != nil {
+		m.CategoryID = types.StringValue(segment.Category.ID)
+		m.CategoryName = types.StringValue(segment.Category.Name)
+	}
+}
+
+// sameScheduleSlot reports whether two start times refer to the same
+// broadcast: the same instant, or for recurring segments the same weekday and
+// time of day in the segment's time zone.
+func sameScheduleSlot(configured, remote, timezone string, recurring bool) bool {
+	a, err := time.Parse(time.RFC3339, configured)
+	if err != nil {
+		return false
+	}
+
+	b, err := time.Parse(time.RFC3339, remote)
+	if err != nil {
+		return false
+	}
+
+	if a.Equal(b) {
+		return true
+	}
+
+	if !recurring {
+		return false
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		location = time.UTC
+	}
+
+	a = a.In(location)
+	b = b.In(location)
+
+	return a.Weekday() == b.Weekday() && a.Hour() == b.Hour() && a.Minute() == b.Minute()
+}
+
+func (c *channelScheduleSegmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_schedule_segment"
+}
+
+func (c *channelScheduleSegmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan channelScheduleSegmentResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	segment, err := c.TwitchClient.CreateScheduleSegment(plan.BroadcasterID.ValueString(), &helix.CreateScheduleSegmentRequest{
+		StartTime:   plan.StartTime.ValueString(),
+		Timezone:    plan.Timezone.ValueString(),
+		Duration:    strconv.Itoa(int(plan.Duration.ValueInt32())),
+		IsRecurring: plan.IsRecurring.ValueBool(),
+		CategoryID:  plan.CategoryID.ValueString(),
+		Title:       plan.Title.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create schedule segment", err.Error())
+		return
+	}
+
+	plan.setSegment(segment)
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *channelScheduleSegmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state channelScheduleSegmentResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	segment, err := c.TwitchClient.GetScheduleSegment(state.BroadcasterID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get schedule segment", err.Error())
+		return
+	}
+
+	if segment == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.setSegment(segment)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *channelScheduleSegmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan channelScheduleSegmentResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	categoryID := plan.CategoryID.ValueString()
+	title := plan.Title.ValueString()
+
+	segment, err := c.TwitchClient.UpdateScheduleSegment(plan.BroadcasterID.ValueString(), plan.ID.ValueString(), &helix.UpdateScheduleSegmentRequest{
+		StartTime:  plan.StartTime.ValueString(),
+		Duration:   strconv.Itoa(int(plan.Duration.ValueInt32())),
+		CategoryID: &categoryID,
+		Title:      &title,
+		Timezone:   plan.Timezone.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update schedule segment", err.Error())
+		return
+	}
+
+	plan.setSegment(segment)
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *channelScheduleSegmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state channelScheduleSegmentResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.TwitchClient.DeleteScheduleSegment(state.BroadcasterID.ValueString(), state.ID.ValueString())
+	if err != nil && !helix.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete schedule segment", err.Error())
+		return
+	}
+}
+
+func (c *channelScheduleSegmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateParts(ctx, req, resp, "broadcaster_id", "id")
+}
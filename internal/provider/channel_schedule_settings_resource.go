@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ell/terraform-provider-twitch/internal/helix"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &channelScheduleSettingsResource{}
+	_ resource.ResourceWithConfigure      = &channelScheduleSettingsResource{}
+	_ resource.ResourceWithValidateConfig = &channelScheduleSettingsResource{}
+	_ resource.ResourceWithImportState    = &channelScheduleSettingsResource{}
+)
+
+type channelScheduleSettingsResource struct {
+	TwitchClient *helix.Client
+}
+
+func (c *channelScheduleSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	twitchClient, ok := req.ProviderData.(*helix.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *helix.Client, got %T", req.ProviderData))
+
+		return
+	}
+
+	c.TwitchClient = twitchClient
+}
+
+func NewChannelScheduleSettingsResource() resource.Resource {
+	return &channelScheduleSettingsResource{}
+}
+
+func (c *channelScheduleSettingsResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages vacation mode on a broadcaster's stream schedule. Destroying the resource disables vacation mode.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"broadcaster_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_vacation_enabled": schema.BoolAttribute{
+				Required: true,
+			},
+			"vacation_start_time": schema.StringAttribute{
+				MarkdownDescription: "RFC3339 start of the vacation. Required when `is_vacation_enabled` is true.",
+				Optional:            true,
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+			"vacation_end_time": schema.StringAttribute{
+				MarkdownDescription: "RFC3339 end of the vacation. Required when `is_vacation_enabled` is true.",
+				Optional:            true,
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+			"timezone": schema.StringAttribute{
+				MarkdownDescription: "IANA time zone of the vacation. Required when `is_vacation_enabled` is true.",
+				Optional:            true,
+				Validators: []validator.String{
+					timezoneValidator{},
+				},
+			},
+		},
+	}
+}
+
+type channelScheduleSettingsResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	BroadcasterID     types.String `tfsdk:"broadcaster_id"`
+	IsVacationEnabled types.Bool   `tfsdk:"is_vacation_enabled"`
+	VacationStartTime types.String `tfsdk:"vacation_start_time"`
+	VacationEndTime   types.String `tfsdk:"vacation_end_time"`
+	Timezone          types.String `tfsdk:"timezone"`
+}
+
+func (c *channelScheduleSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config channelScheduleSettingsResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.IsVacationEnabled.ValueBool() {
+		return
+	}
+
+	for attribute, value := range map[string]types.String{
+		"vacation_start_time": config.VacationStartTime,
+		"vacation_end_time":   config.VacationEndTime,
+		"timezone":            config.Timezone,
+	} {
+		if value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Missing Attribute Configuration",
+				fmt.Sprintf("%s must be set when is_vacation_enabled is true", attribute),
+			)
+		}
+	}
+
+	start, startErr := time.Parse(time.RFC3339, config.VacationStartTime.ValueString())
+	end, endErr := time.Parse(time.RFC3339, config.VacationEndTime.ValueString())
+	if startErr == nil && endErr == nil && !end.After(start) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("vacation_end_time"),
+			"Invalid Vacation Period",
+			"vacation_end_time must be after vacation_start_time",
+		)
+	}
+}
+
+func (c *channelScheduleSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_schedule_settings"
+}
+
+func (c *channelScheduleSettingsResource) updateScheduleSettings(plan *channelScheduleSettingsResourceModel) error {
+	return c.TwitchClient.UpdateScheduleSettings(plan.BroadcasterID.ValueString(), &helix.UpdateScheduleSettingsRequest{
+		IsVacationEnabled: plan.IsVacationEnabled.ValueBool(),
+		VacationStartTime: plan.VacationStartTime.ValueString(),
+		VacationEndTime:   plan.VacationEndTime.ValueString(),
+		Timezone:          plan.Timezone.ValueString(),
+	})
+}
+
+func (c *channelScheduleSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan channelScheduleSettingsResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.updateScheduleSettings(&plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update schedule settings", err.Error())
+		return
+	}
+
+	plan.ID = plan.BroadcasterID
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *channelScheduleSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state channelScheduleSettingsResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule, err := c.TwitchClient.GetSchedule(state.BroadcasterID.ValueString(), "", 1)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get schedule", err.Error())
+		return
+	}
+
+	state.IsVacationEnabled = types.BoolValue(false)
+
+	if schedule != nil && schedule.Vacation != nil {
+		state.IsVacationEnabled = types.BoolValue(true)
+
+		// Twitch reports the vacation in UTC, so keep the configured offset
+		// when it refers to the same instant.
+		if !sameScheduleSlot(state.VacationStartTime.ValueString(), schedule.Vacation.StartTime, "", false) {
+			state.VacationStartTime = types.StringValue(schedule.Vacation.StartTime)
+		}
+
+		if !sameScheduleSlot(state.VacationEndTime.ValueString(), schedule.Vacation.EndTime, "", false) {
+			state.VacationEndTime = types.StringValue(schedule.Vacation.EndTime)
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *channelScheduleSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan channelScheduleSettingsResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.updateScheduleSettings(&plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update schedule settings", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *channelScheduleSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state channelScheduleSettingsResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := c.TwitchClient.UpdateScheduleSettings(state.BroadcasterID.ValueString(), &helix.UpdateScheduleSettingsRequest{
+		IsVacationEnabled: false,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to disable vacation mode", err.Error())
+		return
+	}
+}
+
+func (c *channelScheduleSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("broadcaster_id"), req, resp)
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/ell/terraform-provider-twitch/internal/helix"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &contentClassificationLabelsDataSource{}
+	_ datasource.DataSourceWithConfigure = &contentClassificationLabelsDataSource{}
+)
+
+const defaultContentClassificationLocale = "en-US"
+
+type contentClassificationLabelsDataSource struct {
+	client *helix.Client
+}
+
+type contentClassificationLabelsDataSourceModel struct {
+	Locale types.String                      `tfsdk:"locale"`
+	Labels []contentClassificationLabelModel `tfsdk:"labels"`
+}
+
+type contentClassificationLabelModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (c *contentClassificationLabelsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*helix.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected DataSource Configure Type", "Expected *helix.Client")
+
+		return
+	}
+
+	c.client = client
+}
+
+func (c *contentClassificationLabelsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_content_classification_labels"
+}
+
+func (c *contentClassificationLabelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state contentClassificationLabelsDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	locale := defaultContentClassificationLocale
+	if !state.Locale.IsNull() {
+		locale = state.Locale.ValueString()
+	}
+
+	labels, err := c.client.GetContentClassificationLabels(locale)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get content classification labels", err.Error())
+
+		return
+	}
+
+	state.Labels = []contentClassificationLabelModel{}
+	for _, label := range labels {
+		state.Labels = append(state.Labels, contentClassificationLabelModel{
+			ID:          types.StringValue(label.ID),
+			Name:        types.StringValue(label.Name),
+			Description: types.StringValue(label.Description),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (c *contentClassificationLabelsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the content classification labels a channel can apply, with localized names.",
+		Attributes: map[string]schema.Attribute{
+			"locale": schema.StringAttribute{
+				MarkdownDescription: "Locale of the names and descriptions, such as `de-DE`. Defaults to `en-US`.",
+				Optional:            true,
+			},
+			"labels": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func NewContentClassificationLabelsDataSource() datasource.DataSource {
+	return &contentClassificationLabelsDataSource{}
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &emoteURLFunction{}
+
+const emoteCDNURL = "https://static-cdn.jtvnw.net/emoticons/v2"
+
+var (
+	emoteFormats = []string{"default", "static", "animated"}
+	emoteThemes  = []string{"light", "dark"}
+	emoteScales  = []string{"1.0", "2.0", "3.0"}
+)
+
+type emoteURLFunction struct{}
+
+func NewEmoteURLFunction() function.Function {
+	return &emoteURLFunction{}
+}
+
+func (e *emoteURLFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "emote_url"
+}
+
+func (e *emoteURLFunction) Definition(_ context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build an emote image URL",
+		MarkdownDescription: "Builds the CDN URL of an emote image from its ID, without calling the API.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name: "id",
+			},
+			function.StringParameter{
+				Name:                "format",
+				MarkdownDescription: "`default`, `static` or `animated`.",
+			},
+			function.StringParameter{
+				Name:                "theme",
+				MarkdownDescription: "`light` or `dark`.",
+			},
+			function.StringParameter{
+				Name:                "scale",
+				MarkdownDescription: "`1.0`, `2.0` or `3.0`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (e *emoteURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id, format, theme, scale string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &id, &format, &theme, &scale))
+	if resp.Error != nil {
+		return
+	}
+
+	if id == "" {
+		resp.Error = function.NewArgumentFuncError(0, "id must not be empty")
+		return
+	}
+
+	for position, argument := range []struct {
+		value   string
+		allowed []string
+	}{
+		{format, emoteFormats},
+		{theme, emoteThemes},
+		{scale, emoteScales},
+	} {
+		if !slices.Contains(argument.allowed, argument.value) {
+			resp.Error = function.NewArgumentFuncError(int64(position+1),
+				fmt.Sprintf("must be one of %s, got %q", strings.Join(argument.allowed, ", "), argument.value))
+			return
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, strings.Join([]string{emoteCDNURL, id, format, theme, scale}, "/")))
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestEmoteURLFunction(t *testing.T) {
+	arguments := func(id, format, theme, scale string) []attr.Value {
+		return []attr.Value{types.StringValue(id), types.StringValue(format), types.StringValue(theme), types.StringValue(scale)}
+	}
+
+	testFunction(t, NewEmoteURLFunction(), types.StringUnknown(), []functionTestCase{
+		{
+			name:      "static",
+			arguments: arguments("25", "static", "light", "1.0"),
+			want:      types.StringValue("https://static-cdn.jtvnw.net/emoticons/v2/25/static/light/1.0"),
+		},
+		{
+			name:      "animated",
+			arguments: arguments("emotesv2_dc24652ada1e4c84a5e3ceebae4de709", "animated", "dark", "3.0"),
+			want:      types.StringValue("https://static-cdn.jtvnw.net/emoticons/v2/emotesv2_dc24652ada1e4c84a5e3ceebae4de709/animated/dark/3.0"),
+		},
+		{
+			name:      "empty id",
+			arguments: arguments("", "default", "light", "1.0"),
+			wantErr:   function.NewArgumentFuncError(0, "id must not be empty"),
+		},
+		{
+			name:      "unknown format",
+			arguments: arguments("25", "gif", "light", "1.0"),
+			wantErr:   function.NewArgumentFuncError(1, `must be one of default, static, animated, got "gif"`),
+		},
+		{
+			name:      "unknown theme",
+			arguments: arguments("25", "default", "purple", "1.0"),
+			wantErr:   function.NewArgumentFuncError(2, `must be one of light, dark, got "purple"`),
+		},
+		{
+			name:      "unknown scale",
+			arguments: arguments("25", "default", "dark", "4.0"),
+			wantErr:   function.NewArgumentFuncError(3, `must be one of 1.0, 2.0, 3.0, got "4.0"`),
+		},
+	})
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ell/terraform-provider-twitch/internal/helix"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &eventSubConduitResource{}
+	_ resource.ResourceWithConfigure   = &eventSubConduitResource{}
+	_ resource.ResourceWithImportState = &eventSubConduitResource{}
+)
+
+type eventSubConduitResource struct {
+	TwitchClient *helix.Client
+}
+
+func (e *eventSubConduitResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	twitchClient, ok := req.ProviderData.(*helix.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *helix.Client, got %T", req.ProviderData))
+
+		return
+	}
+
+	e.TwitchClient = twitchClient
+}
+
+func NewEventSubConduitResource() resource.Resource {
+	return &eventSubConduitResource{}
+}
+
+func (e *eventSubConduitResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an EventSub conduit. Conduits require an app access token.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"shard_count": schema.Int32Attribute{
+				Required: true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 20000),
+				},
+			},
+		},
+	}
+}
+
+type eventSubConduitResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	ShardCount types.Int32  `tfsdk:"shard_count"`
+}
+
+func (e *eventSubConduitResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_eventsub_conduit"
+}
+
+func (e *eventSubConduitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan eventSubConduitResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conduit, err := e.TwitchClient.CreateConduit(int(plan.ShardCount.ValueInt32()))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create eventsub conduit", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(conduit.ID)
+	plan.ShardCount = types.Int32Value(int32(conduit.ShardCount))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (e *eventSubConduitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state eventSubConduitResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conduit, err := e.TwitchClient.GetConduitByID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get eventsub conduit", err.Error())
+		return
+	}
+
+	if conduit == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ShardCount = types.Int32Value(int32(conduit.ShardCount))
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (e *eventSubConduitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan eventSubConduitResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conduit, err := e.TwitchClient.UpdateConduit(plan.ID.ValueString(), int(plan.ShardCount.ValueInt32()))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update eventsub conduit", err.Error())
+		return
+	}
+
+	plan.ShardCount = types.Int32Value(int32(conduit.ShardCount))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (e *eventSubConduitResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state eventSubConduitResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := e.TwitchClient.DeleteConduit(state.ID.ValueString())
+	if err != nil && !helix.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete eventsub conduit", err.Error())
+		return
+	}
+}
+
+func (e *eventSubConduitResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
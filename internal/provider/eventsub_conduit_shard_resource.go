@@ -0,0 +1,265 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ell/terraform-provider-twitch/eventsub"
+	"github.com/ell/terraform-provider-twitch/internal/helix"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                     = &eventSubConduitShardResource{}
+	_ resource.ResourceWithConfigure        = &eventSubConduitShardResource{}
+	_ resource.ResourceWithConfigValidators = &eventSubConduitShardResource{}
+	_ resource.ResourceWithImportState      = &eventSubConduitShardResource{}
+)
+
+type eventSubConduitShardResource struct {
+	TwitchClient *helix.Client
+}
+
+func (e *eventSubConduitShardResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	twitchClient, ok := req.ProviderData.(*helix.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *helix.Client, got %T", req.ProviderData))
+
+		return
+	}
+
+	e.TwitchClient = twitchClient
+}
+
+func NewEventSubConduitShardResource() resource.Resource {
+	return &eventSubConduitShardResource{}
+}
+
+func (e *eventSubConduitShardResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Assigns a webhook or websocket transport to a shard of an EventSub conduit. " +
+			"Twitch has no way to unassign a shard, so destroying the resource only removes it from state; " +
+			"lower the conduit's `shard_count` to drop shards. Import with `conduit_id/shard_id`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"conduit_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"shard_id": schema.StringAttribute{
+				MarkdownDescription: "Numeric shard ID, starting at `0`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(numericRegexp, "must be a shard number"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"webhook_callback": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(httpsURLRegexp, "must be an https:// URL"),
+				},
+			},
+			"webhook_secret": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(eventsub.MinSecretLength, eventsub.MaxSecretLength),
+				},
+			},
+			"websocket_session_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *eventSubConduitShardResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("webhook_callback"),
+			path.MatchRoot("websocket_session_id"),
+		),
+		resourcevalidator.RequiredTogether(
+			path.MatchRoot("webhook_callback"),
+			path.MatchRoot("webhook_secret"),
+		),
+	}
+}
+
+type eventSubConduitShardResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	ConduitID          types.String `tfsdk:"conduit_id"`
+	ShardID            types.String `tfsdk:"shard_id"`
+	WebhookCallback    types.String `tfsdk:"webhook_callback"`
+	WebhookSecret      types.String `tfsdk:"webhook_secret"`
+	WebsocketSessionID types.String `tfsdk:"websocket_session_id"`
+	Status             types.String `tfsdk:"status"`
+}
+
+func (m *eventSubConduitShardResourceModel) transport() helix.EventSubTransport {
+	if !m.WebsocketSessionID.IsNull() {
+		return helix.EventSubTransport{
+			Method:    "websocket",
+			SessionID: m.WebsocketSessionID.ValueString(),
+		}
+	}
+
+	return helix.EventSubTransport{
+		Method:   "webhook",
+		Callback: m.WebhookCallback.ValueString(),
+		Secret:   m.WebhookSecret.ValueString(),
+	}
+}
+
+func (m *eventSubConduitShardResourceModel) setShard(shard *helix.ConduitShard) {
+	m.ID = types.StringValue(m.ConduitID.ValueString() + "/" + shard.ID)
+	m.ShardID = types.StringValue(shard.ID)
+	m.Status = types.StringValue(shard.Status)
+
+	switch shard.Transport.Method {
+	case "webhook":
+		m.WebhookCallback = types.StringValue(shard.Transport.Callback)
+		m.WebsocketSessionID = types.StringNull()
+	case "websocket":
+		m.WebsocketSessionID = types.StringValue(shard.Transport.SessionID)
+		m.WebhookCallback = types.StringNull()
+		m.WebhookSecret = types.StringNull()
+	}
+}
+
+func (e *eventSubConduitShardResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_eventsub_conduit_shard"
+}
+
+func (e *eventSubConduitShardResource) updateShard(plan *eventSubConduitShardResourceModel) error {
+	_, err := e.TwitchClient.UpdateConduitShards(&helix.UpdateConduitShardsRequest{
+		ConduitID: plan.ConduitID.ValueString(),
+		Shards: []helix.ConduitShard{
+			{
+				ID:        plan.ShardID.ValueString(),
+				Transport: plan.transport(),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// The update response omits the shard status, so read it back.
+	shard, err := e.TwitchClient.GetConduitShardByID(plan.ConduitID.ValueString(), plan.ShardID.ValueString())
+	if err != nil {
+		return err
+	}
+
+	if shard == nil {
+		return fmt.Errorf("shard %s not found on conduit %s", plan.ShardID.ValueString(), plan.ConduitID.ValueString())
+	}
+
+	plan.ID = types.StringValue(plan.ConduitID.ValueString() + "/" + shard.ID)
+	plan.Status = types.StringValue(shard.Status)
+
+	return nil
+}
+
+func (e *eventSubConduitShardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan eventSubConduitShardResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := e.updateShard(&plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update eventsub conduit shard", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (e *eventSubConduitShardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state eventSubConduitShardResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	shard, err := e.TwitchClient.GetConduitShardByID(state.ConduitID.ValueString(), state.ShardID.ValueString())
+	if helix.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get eventsub conduit shard", err.Error())
+		return
+	}
+
+	if shard == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.setShard(shard)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (e *eventSubConduitShardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan eventSubConduitShardResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := e.updateShard(&plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update eventsub conduit shard", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete only removes the shard from state, since Twitch cannot unassign it.
+func (e *eventSubConduitShardResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+func (e *eventSubConduitShardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateParts(ctx, req, resp, "conduit_id", "shard_id")
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,430 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ell/terraform-provider-twitch/eventsub"
+	"github.com/ell/terraform-provider-twitch/internal/helix"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// eventSubPollInterval is how often subscription status is polled while
+// waiting for Twitch to verify a callback.
+const eventSubPollInterval = 2 * time.Second
+
+var (
+	_ resource.Resource                     = &eventSubSubscriptionResource{}
+	_ resource.ResourceWithConfigure        = &eventSubSubscriptionResource{}
+	_ resource.ResourceWithConfigValidators = &eventSubSubscriptionResource{}
+	_ resource.ResourceWithModifyPlan       = &eventSubSubscriptionResource{}
+	_ resource.ResourceWithImportState      = &eventSubSubscriptionResource{}
+)
+
+type eventSubSubscriptionResource struct {
+	TwitchClient *helix.Client
+}
+
+func (e *eventSubSubscriptionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	twitchClient, ok := req.ProviderData.(*helix.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *helix.Client, got %T", req.ProviderData))
+
+		return
+	}
+
+	e.TwitchClient = twitchClient
+}
+
+func NewEventSubSubscriptionResource() resource.Resource {
+	return &eventSubSubscriptionResource{}
+}
+
+func (e *eventSubSubscriptionResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an EventSub subscription. Subscriptions that Twitch revokes or fails to verify are " +
+			"replaced on the next apply. Events are delivered to either a webhook or a conduit, " +
+			"both of which require an app access token.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Subscription type, for example `channel.follow`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"condition": schema.MapAttribute{
+				MarkdownDescription: "Subscription condition, for example `{ broadcaster_user_id = \"1234\" }`.",
+				ElementType:         types.StringType,
+				Required:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"webhook_callback": schema.StringAttribute{
+				MarkdownDescription: "HTTPS URL that Twitch delivers notifications to. Conflicts with `conduit_id`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(httpsURLRegexp, "must be an https:// URL"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"webhook_secret": schema.StringAttribute{
+				MarkdownDescription: "Secret used to sign notifications, between 10 and 100 characters.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(eventsub.MinSecretLength, eventsub.MaxSecretLength),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"conduit_id": schema.StringAttribute{
+				MarkdownDescription: "Conduit that Twitch delivers notifications to. Conflicts with `webhook_callback`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Wait after creation until Twitch reports the subscription as `enabled`. " +
+					"If it fails verification or `wait_timeout` passes, the subscription is deleted and the apply fails.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"wait_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for the subscription to be enabled. Defaults to `2m`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("2m"),
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cost": schema.Int32Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (e *eventSubSubscriptionResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("webhook_callback"),
+			path.MatchRoot("conduit_id"),
+		),
+		resourcevalidator.RequiredTogether(
+			path.MatchRoot("webhook_callback"),
+			path.MatchRoot("webhook_secret"),
+		),
+	}
+}
+
+type eventSubSubscriptionResourceModel struct {
+	ID              types.String            `tfsdk:"id"`
+	Type            types.String            `tfsdk:"type"`
+	Version         types.String            `tfsdk:"version"`
+	Condition       map[string]types.String `tfsdk:"condition"`
+	WebhookCallback types.String            `tfsdk:"webhook_callback"`
+	WebhookSecret   types.String            `tfsdk:"webhook_secret"`
+	ConduitID       types.String            `tfsdk:"conduit_id"`
+	WaitForEnabled  types.Bool              `tfsdk:"wait_for_enabled"`
+	WaitTimeout     types.String            `tfsdk:"wait_timeout"`
+	Status          types.String            `tfsdk:"status"`
+	CreatedAt       types.String            `tfsdk:"created_at"`
+	Cost            types.Int32             `tfsdk:"cost"`
+}
+
+func (m *eventSubSubscriptionResourceModel) setSubscription(subscription *helix.EventSubSubscription) {
+	m.ID = types.StringValue(subscription.ID)
+	m.Type = types.StringValue(subscription.Type)
+	m.Version = types.StringValue(subscription.Version)
+	m.Status = types.StringValue(subscription.Status)
+	m.CreatedAt = types.StringValue(subscription.CreatedAt)
+	m.Cost = types.Int32Value(int32(subscription.Cost))
+
+	// Twitch returns every condition field, including the ones left empty.
+	m.Condition = map[string]types.String{}
+	for key, value := range subscription.Condition {
+		if value != "" {
+			m.Condition[key] = types.StringValue(value)
+		}
+	}
+
+	if subscription.Transport.Callback != "" {
+		m.WebhookCallback = types.StringValue(subscription.Transport.Callback)
+	}
+
+	if subscription.Transport.ConduitID != "" {
+		m.ConduitID = types.StringValue(subscription.Transport.ConduitID)
+	}
+}
+
+func (m *eventSubSubscriptionResourceModel) transport() helix.EventSubTransport {
+	if !m.ConduitID.IsNull() {
+		return helix.EventSubTransport{
+			Method:    "conduit",
+			ConduitID: m.ConduitID.ValueString(),
+		}
+	}
+
+	return helix.EventSubTransport{
+		Method:   "webhook",
+		Callback: m.WebhookCallback.ValueString(),
+		Secret:   m.WebhookSecret.ValueString(),
+	}
+}
+
+// waitForEnabled polls the subscription until it leaves the pending
+// verification state, returning an error if it ends up in any state other
+// than enabled or the timeout passes.
+func (e *eventSubSubscriptionResource) waitForEnabled(ctx context.Context, subscription *helix.EventSubSubscription, timeout time.Duration) (*helix.EventSubSubscription, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(eventSubPollInterval)
+	defer ticker.Stop()
+
+	for {
+		switch subscription.Status {
+		case helix.EventSubStatusEnabled:
+			return subscription, nil
+		case helix.EventSubStatusWebhookCallbackVerificationPending:
+		default:
+			return nil, fmt.Errorf("subscription %s has status %s", subscription.ID, subscription.Status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("subscription %s was not enabled within %s", subscription.ID, timeout)
+		case <-ticker.C:
+		}
+
+		current, err := e.TwitchClient.GetEventSubSubscription(subscription.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if current == nil {
+			return nil, fmt.Errorf("subscription %s was deleted by Twitch", subscription.ID)
+		}
+
+		subscription = current
+	}
+}
+
+func (e *eventSubSubscriptionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_eventsub_subscription"
+}
+
+func (e *eventSubSubscriptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan eventSubSubscriptionResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	condition := map[string]string{}
+	for key, value := range plan.Condition {
+		condition[key] = value.ValueString()
+	}
+
+	subscription, err := e.TwitchClient.CreateEventSubSubscription(&helix.CreateEventSubSubscriptionRequest{
+		Type:      plan.Type.ValueString(),
+		Version:   plan.Version.ValueString(),
+		Condition: condition,
+		Transport: plan.transport(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create eventsub subscription", err.Error())
+		return
+	}
+
+	if plan.WaitForEnabled.ValueBool() {
+		timeout, _ := time.ParseDuration(plan.WaitTimeout.ValueString())
+
+		enabled, err := e.waitForEnabled(ctx, subscription, timeout)
+		if err != nil {
+			resp.Diagnostics.AddError("EventSub subscription was not enabled", err.Error())
+
+			// Don't leave a subscription behind that Terraform doesn't track.
+			err = e.TwitchClient.DeleteEventSubSubscription(subscription.ID)
+			if err != nil && !helix.IsNotFound(err) {
+				resp.Diagnostics.AddError("Failed to delete eventsub subscription", err.Error())
+			}
+
+			return
+		}
+
+		subscription = enabled
+	}
+
+	plan.setSubscription(subscription)
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (e *eventSubSubscriptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state eventSubSubscriptionResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscription, err := e.TwitchClient.GetEventSubSubscription(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get eventsub subscription", err.Error())
+		return
+	}
+
+	if subscription == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	switch subscription.Status {
+	case helix.EventSubStatusEnabled, helix.EventSubStatusWebhookCallbackVerificationPending:
+	case helix.EventSubStatusWebhookCallbackVerificationFailed:
+		resp.Diagnostics.AddWarning(
+			"EventSub subscription failed verification",
+			fmt.Sprintf("Subscription %s to %s was not verified by %s and will be replaced on the next apply.", subscription.ID, subscription.Type, subscription.Transport.Callback),
+		)
+	default:
+		resp.Diagnostics.AddWarning(
+			"EventSub subscription revoked",
+			fmt.Sprintf("Subscription %s to %s has status %s and will be replaced on the next apply.", subscription.ID, subscription.Type, subscription.Status),
+		)
+	}
+
+	state.setSubscription(subscription)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ModifyPlan replaces subscriptions that Twitch failed to verify or revoked,
+// which Read keeps in state with their status so that the old subscription is
+// deleted during apply rather than on refresh.
+func (e *eventSubSubscriptionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var status types.String
+
+	diags := req.State.GetAttribute(ctx, path.Root("status"), &status)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch status.ValueString() {
+	case helix.EventSubStatusEnabled, helix.EventSubStatusWebhookCallbackVerificationPending:
+		return
+	}
+
+	diags = resp.Plan.SetAttribute(ctx, path.Root("status"), types.StringUnknown())
+	resp.Diagnostics.Append(diags...)
+
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("status"))
+}
+
+// Update only stores the wait settings, since every attribute sent to Twitch
+// requires replacement.
+func (e *eventSubSubscriptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state eventSubSubscriptionResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	plan.Status = state.Status
+	plan.CreatedAt = state.CreatedAt
+	plan.Cost = state.Cost
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (e *eventSubSubscriptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state eventSubSubscriptionResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := e.TwitchClient.DeleteEventSubSubscription(state.ID.ValueString())
+	if err != nil && !helix.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete eventsub subscription", err.Error())
+		return
+	}
+}
+
+func (e *eventSubSubscriptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/ell/terraform-provider-twitch/internal/helix"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = &eventSubSubscriptionsDataSource{}
+	_ datasource.DataSourceWithConfigure        = &eventSubSubscriptionsDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &eventSubSubscriptionsDataSource{}
+)
+
+type eventSubSubscriptionsDataSource struct {
+	client *helix.Client
+}
+
+type eventSubSubscriptionsDataSourceModel struct {
+	Status        types.String                `tfsdk:"status"`
+	Type          types.String                `tfsdk:"type"`
+	UserID        types.String                `tfsdk:"user_id"`
+	Subscriptions []eventSubSubscriptionModel `tfsdk:"subscriptions"`
+	Total         types.Int64                 `tfsdk:"total"`
+	TotalCost     types.Int64                 `tfsdk:"total_cost"`
+	MaxTotalCost  types.Int64                 `tfsdk:"max_total_cost"`
+}
+
+type eventSubSubscriptionModel struct {
+	ID              types.String            `tfsdk:"id"`
+	Status          types.String            `tfsdk:"status"`
+	Type            types.String            `tfsdk:"type"`
+	Version         types.String            `tfsdk:"version"`
+	Condition       map[string]types.String `tfsdk:"condition"`
+	CreatedAt       types.String            `tfsdk:"created_at"`
+	TransportMethod types.String            `tfsdk:"transport_method"`
+	WebhookCallback types.String            `tfsdk:"webhook_callback"`
+	SessionID       types.String            `tfsdk:"session_id"`
+	ConduitID       types.String            `tfsdk:"conduit_id"`
+	Cost            types.Int64             `tfsdk:"cost"`
+}
+
+func (e *eventSubSubscriptionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*helix.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected DataSource Configure Type", "Expected *helix.Client")
+
+		return
+	}
+
+	e.client = client
+}
+
+func (e *eventSubSubscriptionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_eventsub_subscriptions"
+}
+
+func (e *eventSubSubscriptionsDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("status"),
+			path.MatchRoot("type"),
+			path.MatchRoot("user_id"),
+		),
+	}
+}
+
+func (e *eventSubSubscriptionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state eventSubSubscriptionsDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscriptions, err := e.client.GetEventSubSubscriptions(helix.EventSubSubscriptionsFilter{
+		Status: state.Status.ValueString(),
+		Type:   state.Type.ValueString(),
+		UserID: state.UserID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get eventsub subscriptions", err.Error())
+
+		return
+	}
+
+	state.Total = types.Int64Value(int64(subscriptions.Total))
+	state.TotalCost = types.Int64Value(int64(subscriptions.TotalCost))
+	state.MaxTotalCost = types.Int64Value(int64(subscriptions.MaxTotalCost))
+
+	state.Subscriptions = []eventSubSubscriptionModel{}
+	for _, subscription := range subscriptions.Data {
+		condition := map[string]types.String{}
+		for key, value := range subscription.Condition {
+			condition[key] = types.StringValue(value)
+		}
+
+		state.Subscriptions = append(state.Subscriptions, eventSubSubscriptionModel{
+			ID:              types.StringValue(subscription.ID),
+			Status:          types.StringValue(subscription.Status),
+			Type:            types.StringValue(subscription.Type),
+			Version:         types.StringValue(subscription.Version),
+			Condition:       condition,
+			CreatedAt:       types.StringValue(subscription.CreatedAt),
+			TransportMethod: types.StringValue(subscription.Transport.Method),
+			WebhookCallback: types.StringValue(subscription.Transport.Callback),
+			SessionID:       types.StringValue(subscription.Transport.SessionID),
+			ConduitID:       types.StringValue(subscription.Transport.ConduitID),
+			Cost:            types.Int64Value(int64(subscription.Cost)),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (e *eventSubSubscriptionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the EventSub subscriptions of the provider's client ID. At most one of `status`, `type` and `user_id` may be set.",
+		Attributes: map[string]schema.Attribute{
+			"status": schema.StringAttribute{
+				Optional: true,
+			},
+			"type": schema.StringAttribute{
+				Optional: true,
+			},
+			"user_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"subscriptions": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"status": schema.StringAttribute{
+							Computed: true,
+						},
+						"type": schema.StringAttribute{
+							Computed: true,
+						},
+						"version": schema.StringAttribute{
+							Computed: true,
+						},
+						"condition": schema.MapAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Computed: true,
+						},
+						"transport_method": schema.StringAttribute{
+							Computed: true,
+						},
+						"webhook_callback": schema.StringAttribute{
+							Computed: true,
+						},
+						"session_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"conduit_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"cost": schema.Int64Attribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"total": schema.Int64Attribute{
+				MarkdownDescription: "Total number of subscriptions for the client ID, regardless of filters.",
+				Computed:            true,
+			},
+			"total_cost": schema.Int64Attribute{
+				MarkdownDescription: "Sum of the cost of all enabled subscriptions.",
+				Computed:            true,
+			},
+			"max_total_cost": schema.Int64Attribute{
+				MarkdownDescription: "Maximum `total_cost` Twitch allows for the client ID.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func NewEventSubSubscriptionsDataSource() datasource.DataSource {
+	return &eventSubSubscriptionsDataSource{}
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// functionTestCase describes one call of a provider function. Either want or
+// wantErr is set.
+type functionTestCase struct {
+	name      string
+	arguments []attr.Value
+	want      attr.Value
+	wantErr   *function.FuncError
+}
+
+// testFunction runs each case through fn. result is the unknown value of the
+// function's return type the result is decoded into.
+func testFunction(t *testing.T, fn function.Function, result attr.Value, tests []functionTestCase) {
+	t.Helper()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := function.RunResponse{
+				Result: function.NewResultData(result),
+			}
+
+			fn.Run(context.Background(), function.RunRequest{
+				Arguments: function.NewArgumentsData(tt.arguments),
+			}, &resp)
+
+			if !resp.Error.Equal(tt.wantErr) {
+				t.Fatalf("error = %v, want %v", resp.Error, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if got := resp.Result.Value(); !got.Equal(tt.want) {
+				t.Errorf("result = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,16 +2,22 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/ell/terraform-provider-twitch/internal/helix"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
-	_ datasource.DataSource              = &gameDataSource{}
-	_ datasource.DataSourceWithConfigure = &gameDataSource{}
+	_ datasource.DataSource                     = &gameDataSource{}
+	_ datasource.DataSourceWithConfigure        = &gameDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &gameDataSource{}
 )
 
 type gameDataSource struct {
@@ -19,10 +25,16 @@ type gameDataSource struct {
 }
 
 type gameDataSourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	Name      types.String `tfsdk:"name"`
-	BoxArtURL types.String `tfsdk:"box_art_url"`
-	IGDBID    types.String `tfsdk:"igdb_id"`
+	ID                types.String            `tfsdk:"id"`
+	Name              types.String            `tfsdk:"name"`
+	BoxArtURL         types.String            `tfsdk:"box_art_url"`
+	IGDBID            types.String            `tfsdk:"igdb_id"`
+	Fuzzy             types.Bool              `tfsdk:"fuzzy"`
+	MatchedName       types.String            `tfsdk:"matched_name"`
+	BoxArtWidth       types.Int32             `tfsdk:"box_art_width"`
+	BoxArtHeight      types.Int32             `tfsdk:"box_art_height"`
+	RenderedBoxArtURL types.String            `tfsdk:"rendered_box_art_url"`
+	BoxArtURLs        map[string]types.String `tfsdk:"box_art_urls"`
 }
 
 func (g *gameDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -44,6 +56,15 @@ func (g *gameDataSource) Metadata(ctx context.Context, req datasource.MetadataRe
 	resp.TypeName = req.ProviderTypeName + "_game"
 }
 
+func (g *gameDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.RequiredTogether(
+			path.MatchRoot("box_art_width"),
+			path.MatchRoot("box_art_height"),
+		),
+	}
+}
+
 func (g *gameDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state gameDataSourceModel
 
@@ -61,6 +82,15 @@ func (g *gameDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
+	if len(games.Data) == 0 && state.Fuzzy.ValueBool() {
+		games, err = g.searchGame(state.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to search for game", err.Error())
+
+			return
+		}
+	}
+
 	if len(games.Data) == 0 {
 		resp.Diagnostics.AddError("No game found", "No game found with the provided name")
 
@@ -69,12 +99,55 @@ func (g *gameDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	game := games.Data[0]
 
-	resp.State.Set(ctx, gameDataSourceModel{
-		ID:        types.StringValue(game.ID),
-		Name:      types.StringValue(game.Name),
-		BoxArtURL: types.StringValue(game.BoxArtURL),
-		IGDBID:    types.StringValue(game.IGDBID),
+	name := types.StringValue(game.Name)
+	if state.Fuzzy.ValueBool() {
+		// Keep the configured name so the data source matches its config.
+		name = state.Name
+
+		if game.Name != state.Name.ValueString() {
+			resp.Diagnostics.AddWarning("Inexact game match",
+				fmt.Sprintf("No game is named %q exactly; using the closest match %q (%s).", state.Name.ValueString(), game.Name, game.ID))
+		}
+	}
+
+	rendered := types.StringNull()
+	if !state.BoxArtWidth.IsNull() {
+		rendered = types.StringValue(helix.RenderBoxArtURL(game.BoxArtURL, int(state.BoxArtWidth.ValueInt32()), int(state.BoxArtHeight.ValueInt32())))
+	}
+
+	boxArtURLs := map[string]types.String{}
+	for size, dimensions := range boxArtSizes {
+		boxArtURLs[size] = types.StringValue(helix.RenderBoxArtURL(game.BoxArtURL, dimensions[0], dimensions[1]))
+	}
+
+	diags = resp.State.Set(ctx, gameDataSourceModel{
+		ID:                types.StringValue(game.ID),
+		Name:              name,
+		BoxArtURL:         types.StringValue(game.BoxArtURL),
+		IGDBID:            types.StringValue(game.IGDBID),
+		Fuzzy:             state.Fuzzy,
+		MatchedName:       types.StringValue(game.Name),
+		BoxArtWidth:       state.BoxArtWidth,
+		BoxArtHeight:      state.BoxArtHeight,
+		RenderedBoxArtURL: rendered,
+		BoxArtURLs:        boxArtURLs,
 	})
+	resp.Diagnostics.Append(diags...)
+}
+
+// searchGame finds the category that best matches name and looks it up by ID,
+// since search results do not include the IGDB ID.
+func (g *gameDataSource) searchGame(name string) (*helix.GetGameResponse, error) {
+	categories, err := g.client.SearchCategories(name, defaultSearchCategoriesLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(categories) == 0 {
+		return &helix.GetGameResponse{}, nil
+	}
+
+	return g.client.GetGameById(bestCategoryMatch(name, categories).ID)
 }
 
 func (g *gameDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -87,11 +160,44 @@ func (g *gameDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 				Required: true,
 			},
 			"box_art_url": schema.StringAttribute{
-				Computed: true,
+				MarkdownDescription: "Box art URL template with `{width}` and `{height}` placeholders.",
+				Computed:            true,
+			},
+			"box_art_width": schema.Int32Attribute{
+				MarkdownDescription: "Width in pixels to render `rendered_box_art_url` at.",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"box_art_height": schema.Int32Attribute{
+				MarkdownDescription: "Height in pixels to render `rendered_box_art_url` at.",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"rendered_box_art_url": schema.StringAttribute{
+				MarkdownDescription: "Box art URL at `box_art_width` by `box_art_height`, or null if they are not set.",
+				Computed:            true,
+			},
+			"box_art_urls": schema.MapAttribute{
+				MarkdownDescription: "Box art URLs at the sizes Twitch uses, keyed `52x72`, `144x192` and `285x380`.",
+				ElementType:         types.StringType,
+				Computed:            true,
 			},
 			"igdb_id": schema.StringAttribute{
 				Computed: true,
 			},
+			"fuzzy": schema.BoolAttribute{
+				MarkdownDescription: "When no game has exactly this `name`, search categories and use the closest match, " +
+					"ignoring case and diacritics. A warning is emitted when the match is not exact.",
+				Optional: true,
+			},
+			"matched_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the game that was found.",
+				Computed:            true,
+			},
 		},
 	}
 }
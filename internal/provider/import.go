@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// importStateParts splits an import ID of the form "a/b" into the given
+// attributes, in order.
+func importStateParts(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, attributes ...string) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != len(attributes) {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format %s, got %q", strings.Join(attributes, "/"), req.ID),
+		)
+
+		return
+	}
+
+	for i, attribute := range attributes {
+		if parts[i] == "" {
+			resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Import identifier %q has an empty %s", req.ID, attribute))
+
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attribute), parts[i])...)
+	}
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &isValidColorFunction{}
+
+type isValidColorFunction struct{}
+
+func NewIsValidColorFunction() function.Function {
+	return &isValidColorFunction{}
+}
+
+func (i *isValidColorFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "is_valid_color"
+}
+
+func (i *isValidColorFunction) Definition(_ context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Check a reward background color",
+		MarkdownDescription: "Returns true if the value is a `#RRGGBB` hex color, as Twitch requires for reward background colors.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name: "color",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (i *isValidColorFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var color string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &color))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, hexColorRegexp.MatchString(color)))
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIsValidColorFunction(t *testing.T) {
+	testFunction(t, NewIsValidColorFunction(), types.BoolUnknown(), []functionTestCase{
+		{
+			name:      "upper case",
+			arguments: []attr.Value{types.StringValue("#FFBF00")},
+			want:      types.BoolValue(true),
+		},
+		{
+			name:      "lower case",
+			arguments: []attr.Value{types.StringValue("#9146ff")},
+			want:      types.BoolValue(true),
+		},
+		{
+			name:      "missing hash",
+			arguments: []attr.Value{types.StringValue("FFBF00")},
+			want:      types.BoolValue(false),
+		},
+		{
+			name:      "short form",
+			arguments: []attr.Value{types.StringValue("#FB0")},
+			want:      types.BoolValue(false),
+		},
+		{
+			name:      "not hex",
+			arguments: []attr.Value{types.StringValue("#GGGGGG")},
+			want:      types.BoolValue(false),
+		},
+		{
+			name:      "empty",
+			arguments: []attr.Value{types.StringValue("")},
+			want:      types.BoolValue(false),
+		},
+	})
+}
@@ -0,0 +1,15 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// moderatorIDOrBroadcaster returns the configured moderator, falling back to
+// the broadcaster when the token belongs to the broadcaster themselves.
+func moderatorIDOrBroadcaster(moderatorID, broadcasterID types.String) string {
+	if moderatorID.IsNull() || moderatorID.IsUnknown() {
+		return broadcasterID.ValueString()
+	}
+
+	return moderatorID.ValueString()
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &normalizeTagFunction{}
+
+type normalizeTagFunction struct{}
+
+func NewNormalizeTagFunction() function.Function {
+	return &normalizeTagFunction{}
+}
+
+func (n *normalizeTagFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_tag"
+}
+
+func (n *normalizeTagFunction) Definition(_ context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Turn a string into a valid channel tag",
+		MarkdownDescription: "Removes spaces and special characters and truncates the result to 25 characters, " +
+			"so it can be used in `twitch_channel` `tags`. Fails if nothing is left.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name: "tag",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (n *normalizeTagFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var tag string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &tag))
+	if resp.Error != nil {
+		return
+	}
+
+	normalized := []rune(strings.Map(func(r rune) rune {
+		if isTagRune(r) {
+			return r
+		}
+
+		return -1
+	}, tag))
+
+	if len(normalized) == 0 {
+		resp.Error = function.NewArgumentFuncError(0, "tag has no letters or digits")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, string(normalized[:min(len(normalized), maxTagLength)])))
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNormalizeTagFunction(t *testing.T) {
+	testFunction(t, NewNormalizeTagFunction(), types.StringUnknown(), []functionTestCase{
+		{
+			name:      "already valid",
+			arguments: []attr.Value{types.StringValue("English")},
+			want:      types.StringValue("English"),
+		},
+		{
+			name:      "strips spaces and punctuation",
+			arguments: []attr.Value{types.StringValue("Speed-run: Any%!")},
+			want:      types.StringValue("SpeedrunAny"),
+		},
+		{
+			name:      "keeps non-latin letters",
+			arguments: []attr.Value{types.StringValue("日本語 2")},
+			want:      types.StringValue("日本語2"),
+		},
+		{
+			name:      "truncates to 25 characters",
+			arguments: []attr.Value{types.StringValue("abcdefghij klmnopqrst uvwxyz0123")},
+			want:      types.StringValue("abcdefghijklmnopqrstuvwxy"),
+		},
+		{
+			name:      "nothing left",
+			arguments: []attr.Value{types.StringValue(" -!? ")},
+			wantErr:   function.NewArgumentFuncError(0, "tag has no letters or digits"),
+		},
+	})
+}
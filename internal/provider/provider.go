@@ -85,17 +85,46 @@ func (p *TwitchProvider) Resources(ctx context.Context) []func() resource.Resour
 	return []func() resource.Resource{
 		NewChannelResource,
 		NewChannelRewardResource,
+		NewAutoModSettingsResource,
+		NewBlockedTermResource,
+		NewBlockedTermsResource,
+		NewBannedUserResource,
+		NewChannelScheduleSegmentResource,
+		NewChannelScheduleSettingsResource,
+		NewEventSubSubscriptionResource,
+		NewEventSubConduitResource,
+		NewEventSubConduitShardResource,
+		NewUserDescriptionResource,
+		NewChannelRewardSetResource,
+		NewChannelRewardPauseResource,
 	}
 }
 
 func (p *TwitchProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewGameDataSource,
+		NewBannedUsersDataSource,
+		NewChannelScheduleDataSource,
+		NewEventSubSubscriptionsDataSource,
+		NewUserDataSource,
+		NewUsersDataSource,
+		NewStreamDataSource,
+		NewSearchCategoriesDataSource,
+		NewTopGamesDataSource,
+		NewChannelRewardRedemptionsDataSource,
+		NewContentClassificationLabelsDataSource,
+		NewChannelEditorsDataSource,
+		NewChannelFollowersDataSource,
 	}
 }
 
 func (p *TwitchProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewBoxArtURLFunction,
+		NewNormalizeTagFunction,
+		NewIsValidColorFunction,
+		NewEmoteURLFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
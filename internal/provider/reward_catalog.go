@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+// rewardCatalog is the layout of a twitch_channel_reward_set catalog file.
+// JSON catalogs use the same keys, since JSON is valid YAML.
+type rewardCatalog struct {
+	Rewards map[string]rewardCatalogEntry `yaml:"rewards"`
+}
+
+type rewardCatalogEntry struct {
+	Title                   string  `yaml:"title"`
+	Cost                    int32   `yaml:"cost"`
+	Prompt                  *string `yaml:"prompt"`
+	BackgroundColor         *string `yaml:"background_color"`
+	IsEnabled               *bool   `yaml:"is_enabled"`
+	IsPaused                *bool   `yaml:"is_paused"`
+	IsGlobalCooldownEnabled *bool   `yaml:"is_global_cooldown_enabled"`
+	GlobalCooldownSeconds   *int32  `yaml:"global_cooldown_seconds"`
+}
+
+// loadRewardCatalog reads a catalog file and returns its rewards with the
+// same defaults the rewards attribute applies. is_paused has no default and
+// is null when unset. Unknown keys are rejected so typos do not silently fall
+// back to defaults.
+func loadRewardCatalog(name string) (map[string]channelRewardSetItemModel, error) {
+	content, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var catalog rewardCatalog
+
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+
+	err = decoder.Decode(&catalog)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", name, err)
+	}
+
+	rewards := map[string]channelRewardSetItemModel{}
+	for key, entry := range catalog.Rewards {
+		item := channelRewardSetItemModel{
+			ID:                      types.StringUnknown(),
+			Title:                   types.StringValue(entry.Title),
+			Cost:                    types.Int32Value(entry.Cost),
+			Prompt:                  types.StringValue(""),
+			BackgroundColor:         types.StringValue(defaultRewardBackgroundColor),
+			IsEnabled:               types.BoolValue(true),
+			IsPaused:                types.BoolNull(),
+			IsGlobalCooldownEnabled: types.BoolValue(false),
+			GlobalCooldownSeconds:   types.Int32Value(0),
+		}
+
+		if entry.Prompt != nil {
+			item.Prompt = types.StringValue(*entry.Prompt)
+		}
+
+		if entry.BackgroundColor != nil {
+			item.BackgroundColor = types.StringValue(*entry.BackgroundColor)
+		}
+
+		if entry.IsEnabled != nil {
+			item.IsEnabled = types.BoolValue(*entry.IsEnabled)
+		}
+
+		if entry.IsPaused != nil {
+			item.IsPaused = types.BoolValue(*entry.IsPaused)
+		}
+
+		if entry.IsGlobalCooldownEnabled != nil {
+			item.IsGlobalCooldownEnabled = types.BoolValue(*entry.IsGlobalCooldownEnabled)
+		}
+
+		if entry.GlobalCooldownSeconds != nil {
+			item.GlobalCooldownSeconds = types.Int32Value(*entry.GlobalCooldownSeconds)
+		}
+
+		rewards[key] = item
+	}
+
+	return rewards, nil
+}
+
+// checkRewardCatalog applies the twitch_channel_reward validation rules to
+// catalog rewards, which schema validators never see.
+func checkRewardCatalog(rewards map[string]channelRewardSetItemModel) []error {
+	keys := make([]string, 0, len(rewards))
+	for key := range rewards {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	errs := []error{}
+
+	for _, key := range keys {
+		item := rewards[key]
+
+		titleLength := utf8.RuneCountInString(item.Title.ValueString())
+		if titleLength < 1 || titleLength > 45 {
+			errs = append(errs, fmt.Errorf("reward %q: title must be 1 to 45 characters", key))
+		}
+
+		if utf8.RuneCountInString(item.Prompt.ValueString()) > 200 {
+			errs = append(errs, fmt.Errorf("reward %q: prompt must be at most 200 characters", key))
+		}
+
+		if item.Cost.ValueInt32() < 1 {
+			errs = append(errs, fmt.Errorf("reward %q: cost must be at least 1", key))
+		}
+
+		if !hexColorRegexp.MatchString(item.BackgroundColor.ValueString()) {
+			errs = append(errs, fmt.Errorf("reward %q: background_color must be a #RRGGBB hex color", key))
+		}
+
+		seconds := item.GlobalCooldownSeconds.ValueInt32()
+		if seconds < 0 || seconds > maxGlobalCooldownSeconds {
+			errs = append(errs, fmt.Errorf("reward %q: global_cooldown_seconds must be between 0 and %d", key, maxGlobalCooldownSeconds))
+		}
+
+		err := checkRewardCooldown(item.IsGlobalCooldownEnabled.ValueBool(), seconds)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("reward %q: %w", key, err))
+		}
+	}
+
+	return errs
+}
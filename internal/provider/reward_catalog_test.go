@@ -0,0 +1,244 @@
+package provider
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestLoadRewardCatalog(t *testing.T) {
+	defaults := func(title string, cost int32) channelRewardSetItemModel {
+		return channelRewardSetItemModel{
+			ID:                      types.StringUnknown(),
+			Title:                   types.StringValue(title),
+			Cost:                    types.Int32Value(cost),
+			Prompt:                  types.StringValue(""),
+			BackgroundColor:         types.StringValue(defaultRewardBackgroundColor),
+			IsEnabled:               types.BoolValue(true),
+			IsPaused:                types.BoolNull(),
+			IsGlobalCooldownEnabled: types.BoolValue(false),
+			GlobalCooldownSeconds:   types.Int32Value(0),
+		}
+	}
+
+	configured := defaults("Hydrate", 500)
+	configured.Prompt = types.StringValue("Drink some water")
+	configured.BackgroundColor = types.StringValue("#00A8FF")
+	configured.IsEnabled = types.BoolValue(false)
+	configured.IsPaused = types.BoolValue(true)
+	configured.IsGlobalCooldownEnabled = types.BoolValue(true)
+	configured.GlobalCooldownSeconds = types.Int32Value(300)
+
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		want    map[string]channelRewardSetItemModel
+		wantErr string
+	}{
+		{
+			name: "yaml defaults",
+			file: "rewards.yaml",
+			content: `rewards:
+  hydrate:
+    title: Hydrate
+    cost: 500
+`,
+			want: map[string]channelRewardSetItemModel{
+				"hydrate": defaults("Hydrate", 500),
+			},
+		},
+		{
+			name: "yaml all fields",
+			file: "rewards.yaml",
+			content: `rewards:
+  hydrate:
+    title: Hydrate
+    cost: 500
+    prompt: Drink some water
+    background_color: "#00A8FF"
+    is_enabled: false
+    is_paused: true
+    is_global_cooldown_enabled: true
+    global_cooldown_seconds: 300
+`,
+			want: map[string]channelRewardSetItemModel{
+				"hydrate": configured,
+			},
+		},
+		{
+			name:    "json",
+			file:    "rewards.json",
+			content: `{"rewards": {"hydrate": {"title": "Hydrate", "cost": 500}, "stretch": {"title": "Stretch", "cost": 1000}}}`,
+			want: map[string]channelRewardSetItemModel{
+				"hydrate": defaults("Hydrate", 500),
+				"stretch": defaults("Stretch", 1000),
+			},
+		},
+		{
+			name: "unknown key",
+			file: "rewards.yaml",
+			content: `rewards:
+  hydrate:
+    title: Hydrate
+    cots: 500
+`,
+			wantErr: "field cots not found",
+		},
+		{
+			name:    "invalid json",
+			file:    "rewards.json",
+			content: `{"rewards": {`,
+			wantErr: "parsing",
+		},
+		{
+			name:    "missing file",
+			file:    "",
+			wantErr: "no such file",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name := filepath.Join(t.TempDir(), "missing.yaml")
+			if tt.file != "" {
+				name = filepath.Join(t.TempDir(), tt.file)
+
+				err := os.WriteFile(name, []byte(tt.content), 0o600)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got, err := loadRewardCatalog(name)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want it to contain %q", err, tt.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("rewards = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckRewardCatalog(t *testing.T) {
+	valid := channelRewardSetItemModel{
+		Title:                   types.StringValue("Hydrate"),
+		Cost:                    types.Int32Value(500),
+		Prompt:                  types.StringValue(""),
+		BackgroundColor:         types.StringValue(defaultRewardBackgroundColor),
+		IsGlobalCooldownEnabled: types.BoolValue(false),
+		GlobalCooldownSeconds:   types.Int32Value(0),
+	}
+
+	modified := func(modify func(item *channelRewardSetItemModel)) channelRewardSetItemModel {
+		item := valid
+		modify(&item)
+
+		return item
+	}
+
+	tests := []struct {
+		name    string
+		rewards map[string]channelRewardSetItemModel
+		want    []string
+	}{
+		{
+			name:    "valid",
+			rewards: map[string]channelRewardSetItemModel{"hydrate": valid},
+			want:    []string{},
+		},
+		{
+			name: "empty title",
+			rewards: map[string]channelRewardSetItemModel{"hydrate": modified(func(item *channelRewardSetItemModel) {
+				item.Title = types.StringValue("")
+			})},
+			want: []string{`reward "hydrate": title must be 1 to 45 characters`},
+		},
+		{
+			name: "long title",
+			rewards: map[string]channelRewardSetItemModel{"hydrate": modified(func(item *channelRewardSetItemModel) {
+				item.Title = types.StringValue(strings.Repeat("a", 46))
+			})},
+			want: []string{`reward "hydrate": title must be 1 to 45 characters`},
+		},
+		{
+			name: "long prompt",
+			rewards: map[string]channelRewardSetItemModel{"hydrate": modified(func(item *channelRewardSetItemModel) {
+				item.Prompt = types.StringValue(strings.Repeat("a", 201))
+			})},
+			want: []string{`reward "hydrate": prompt must be at most 200 characters`},
+		},
+		{
+			name: "zero cost",
+			rewards: map[string]channelRewardSetItemModel{"hydrate": modified(func(item *channelRewardSetItemModel) {
+				item.Cost = types.Int32Value(0)
+			})},
+			want: []string{`reward "hydrate": cost must be at least 1`},
+		},
+		{
+			name: "invalid color",
+			rewards: map[string]channelRewardSetItemModel{"hydrate": modified(func(item *channelRewardSetItemModel) {
+				item.BackgroundColor = types.StringValue("blue")
+			})},
+			want: []string{`reward "hydrate": background_color must be a #RRGGBB hex color`},
+		},
+		{
+			name: "cooldown too long",
+			rewards: map[string]channelRewardSetItemModel{"hydrate": modified(func(item *channelRewardSetItemModel) {
+				item.IsGlobalCooldownEnabled = types.BoolValue(true)
+				item.GlobalCooldownSeconds = types.Int32Value(maxGlobalCooldownSeconds + 1)
+			})},
+			want: []string{`reward "hydrate": global_cooldown_seconds must be between 0 and 604800`},
+		},
+		{
+			name: "cooldown without enabling it",
+			rewards: map[string]channelRewardSetItemModel{"hydrate": modified(func(item *channelRewardSetItemModel) {
+				item.GlobalCooldownSeconds = types.Int32Value(60)
+			})},
+			want: []string{`reward "hydrate": global_cooldown_seconds requires is_global_cooldown_enabled to be true`},
+		},
+		{
+			name: "errors sorted by key",
+			rewards: map[string]channelRewardSetItemModel{
+				"stretch": modified(func(item *channelRewardSetItemModel) {
+					item.Cost = types.Int32Value(0)
+				}),
+				"hydrate": modified(func(item *channelRewardSetItemModel) {
+					item.Title = types.StringValue("")
+				}),
+			},
+			want: []string{
+				`reward "hydrate": title must be 1 to 45 characters`,
+				`reward "stretch": cost must be at least 1`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{}
+			for _, err := range checkRewardCatalog(tt.rewards) {
+				got = append(got, err.Error())
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("errors = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"github.com/ell/terraform-provider-twitch/internal/helix"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+var (
+	_ datasource.DataSource              = &searchCategoriesDataSource{}
+	_ datasource.DataSourceWithConfigure = &searchCategoriesDataSource{}
+)
+
+const defaultSearchCategoriesLimit = 20
+
+// foldCategoryName lowercases name and strips diacritics, so "Pokémon" and
+// "pokemon" compare equal.
+func foldCategoryName(name string) string {
+	folded, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), name)
+	if err != nil {
+		folded = name
+	}
+
+	return strings.ToLower(strings.TrimSpace(folded))
+}
+
+// bestCategoryMatch returns the first candidate whose folded name equals the
+// folded query, falling back to the most relevant candidate.
+func bestCategoryMatch(query string, candidates []helix.Category) helix.Category {
+	for _, candidate := range candidates {
+		if foldCategoryName(candidate.Name) == foldCategoryName(query) {
+			return candidate
+		}
+	}
+
+	return candidates[0]
+}
+
+type searchCategoriesDataSource struct {
+	client *helix.Client
+}
+
+type searchCategoriesDataSourceModel struct {
+	Query      types.String    `tfsdk:"query"`
+	Limit      types.Int32     `tfsdk:"limit"`
+	Categories []categoryModel `tfsdk:"categories"`
+}
+
+type categoryModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	BoxArtURL types.String `tfsdk:"box_art_url"`
+}
+
+func (s *searchCategoriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*helix.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected DataSource Configure Type", "Expected *helix.Client")
+
+		return
+	}
+
+	s.client = client
+}
+
+func (s *searchCategoriesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_search_categories"
+}
+
+func (s *searchCategoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state searchCategoriesDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit := defaultSearchCategoriesLimit
+	if !state.Limit.IsNull() {
+		limit = int(state.Limit.ValueInt32())
+	}
+
+	categories, err := s.client.SearchCategories(state.Query.ValueString(), limit)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to search categories", err.Error())
+
+		return
+	}
+
+	state.Categories = []categoryModel{}
+	for _, category := range categories {
+		state.Categories = append(state.Categories, categoryModel{
+			ID:        types.StringValue(category.ID),
+			Name:      types.StringValue(category.Name),
+			BoxArtURL: types.StringValue(category.BoxArtURL),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (s *searchCategoriesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Searches categories by name. `categories` is ordered by relevance, best match first.",
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{
+				Required: true,
+			},
+			"limit": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of categories to return. Defaults to 20.",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 100),
+				},
+			},
+			"categories": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"box_art_url": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func NewSearchCategoriesDataSource() datasource.DataSource {
+	return &searchCategoriesDataSource{}
+}
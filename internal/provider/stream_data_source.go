@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/ell/terraform-provider-twitch/internal/helix"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = &streamDataSource{}
+	_ datasource.DataSourceWithConfigure        = &streamDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &streamDataSource{}
+)
+
+type streamDataSource struct {
+	client *helix.Client
+}
+
+type streamDataSourceModel struct {
+	UserID      types.String   `tfsdk:"user_id"`
+	UserLogin   types.String   `tfsdk:"user_login"`
+	IsLive      types.Bool     `tfsdk:"is_live"`
+	ID          types.String   `tfsdk:"id"`
+	StartedAt   types.String   `tfsdk:"started_at"`
+	ViewerCount types.Int64    `tfsdk:"viewer_count"`
+	GameID      types.String   `tfsdk:"game_id"`
+	GameName    types.String   `tfsdk:"game_name"`
+	Title       types.String   `tfsdk:"title"`
+	Tags        []types.String `tfsdk:"tags"`
+}
+
+func (s *streamDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*helix.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected DataSource Configure Type", "Expected *helix.Client")
+
+		return
+	}
+
+	s.client = client
+}
+
+func (s *streamDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stream"
+}
+
+func (s *streamDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("user_id"),
+			path.MatchRoot("user_login"),
+		),
+	}
+}
+
+func (s *streamDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state streamDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stream, err := s.client.GetStream(state.UserID.ValueString(), state.UserLogin.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get stream", err.Error())
+
+		return
+	}
+
+	state.IsLive = types.BoolValue(stream != nil)
+	state.ID = types.StringNull()
+	state.StartedAt = types.StringNull()
+	state.ViewerCount = types.Int64Value(0)
+	state.GameID = types.StringNull()
+	state.GameName = types.StringNull()
+	state.Title = types.StringNull()
+	state.Tags = []types.String{}
+
+	if stream != nil {
+		state.UserID = types.StringValue(stream.UserID)
+		state.UserLogin = types.StringValue(stream.UserLogin)
+		state.ID = types.StringValue(stream.ID)
+		state.StartedAt = types.StringValue(stream.StartedAt)
+		state.ViewerCount = types.Int64Value(int64(stream.ViewerCount))
+		state.GameID = types.StringValue(stream.GameID)
+		state.GameName = types.StringValue(stream.GameName)
+		state.Title = types.StringValue(stream.Title)
+
+		for _, tag := range stream.Tags {
+			state.Tags = append(state.Tags, types.StringValue(tag))
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (s *streamDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reports whether a channel is live. When it is offline, `is_live` is false and the stream attributes are null.",
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"user_login": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"is_live": schema.BoolAttribute{
+				Computed: true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the live stream.",
+				Computed:            true,
+			},
+			"started_at": schema.StringAttribute{
+				Computed: true,
+			},
+			"viewer_count": schema.Int64Attribute{
+				Computed: true,
+			},
+			"game_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"game_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"title": schema.StringAttribute{
+				Computed: true,
+			},
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func NewStreamDataSource() datasource.DataSource {
+	return &streamDataSource{}
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/ell/terraform-provider-twitch/internal/helix"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &topGamesDataSource{}
+	_ datasource.DataSourceWithConfigure = &topGamesDataSource{}
+)
+
+const defaultTopGamesLimit = 20
+
+type topGamesDataSource struct {
+	client *helix.Client
+}
+
+type topGamesDataSourceModel struct {
+	Limit types.Int32    `tfsdk:"limit"`
+	Games []topGameModel `tfsdk:"games"`
+}
+
+type topGameModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	BoxArtURL types.String `tfsdk:"box_art_url"`
+	IGDBID    types.String `tfsdk:"igdb_id"`
+}
+
+func (t *topGamesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*helix.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected DataSource Configure Type", "Expected *helix.Client")
+
+		return
+	}
+
+	t.client = client
+}
+
+func (t *topGamesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_top_games"
+}
+
+func (t *topGamesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state topGamesDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit := defaultTopGamesLimit
+	if !state.Limit.IsNull() {
+		limit = int(state.Limit.ValueInt32())
+	}
+
+	games, err := t.client.GetTopGames(limit)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get top games", err.Error())
+
+		return
+	}
+
+	state.Games = []topGameModel{}
+	for _, game := range games {
+		state.Games = append(state.Games, topGameModel{
+			ID:        types.StringValue(game.ID),
+			Name:      types.StringValue(game.Name),
+			BoxArtURL: types.StringValue(game.BoxArtURL),
+			IGDBID:    types.StringValue(game.IGDBID),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (t *topGamesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the most watched games, ordered by current viewer count.",
+		Attributes: map[string]schema.Attribute{
+			"limit": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of games to return. Defaults to 20.",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 500),
+				},
+			},
+			"games": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"box_art_url": schema.StringAttribute{
+							Computed: true,
+						},
+						"igdb_id": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func NewTopGamesDataSource() datasource.DataSource {
+	return &topGamesDataSource{}
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/ell/terraform-provider-twitch/internal/helix"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = &userDataSource{}
+	_ datasource.DataSourceWithConfigure        = &userDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &userDataSource{}
+)
+
+type userDataSource struct {
+	client *helix.Client
+}
+
+type userDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Login           types.String `tfsdk:"login"`
+	DisplayName     types.String `tfsdk:"display_name"`
+	Type            types.String `tfsdk:"type"`
+	BroadcasterType types.String `tfsdk:"broadcaster_type"`
+	Description     types.String `tfsdk:"description"`
+	ProfileImageURL types.String `tfsdk:"profile_image_url"`
+	OfflineImageURL types.String `tfsdk:"offline_image_url"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+}
+
+func newUserDataSourceModel(user helix.User) userDataSourceModel {
+	return userDataSourceModel{
+		ID:              types.StringValue(user.ID),
+		Login:           types.StringValue(user.Login),
+		DisplayName:     types.StringValue(user.DisplayName),
+		Type:            types.StringValue(user.Type),
+		BroadcasterType: types.StringValue(user.BroadcasterType),
+		Description:     types.StringValue(user.Description),
+		ProfileImageURL: types.StringValue(user.ProfileImageURL),
+		OfflineImageURL: types.StringValue(user.OfflineImageURL),
+		CreatedAt:       types.StringValue(user.CreatedAt),
+	}
+}
+
+func (u *userDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*helix.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected DataSource Configure Type", "Expected *helix.Client")
+
+		return
+	}
+
+	u.client = client
+}
+
+func (u *userDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (u *userDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("id"),
+			path.MatchRoot("login"),
+		),
+	}
+}
+
+func (u *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state userDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := []string{}
+	if !state.ID.IsNull() {
+		ids = append(ids, state.ID.ValueString())
+	}
+
+	logins := []string{}
+	if !state.Login.IsNull() {
+		logins = append(logins, state.Login.ValueString())
+	}
+
+	users, err := u.client.GetUsers(ids, logins)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get user", err.Error())
+
+		return
+	}
+
+	if len(users) == 0 {
+		resp.Diagnostics.AddError("No user found", "No user found with the provided id or login")
+
+		return
+	}
+
+	diags = resp.State.Set(ctx, newUserDataSourceModel(users[0]))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (u *userDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a user by `id` or `login`. With neither set, returns the user the access token belongs to.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"login": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"display_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"type": schema.StringAttribute{
+				Computed: true,
+			},
+			"broadcaster_type": schema.StringAttribute{
+				MarkdownDescription: "`partner`, `affiliate`, or empty for a normal broadcaster.",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				Computed: true,
+			},
+			"profile_image_url": schema.StringAttribute{
+				Computed: true,
+			},
+			"offline_image_url": schema.StringAttribute{
+				Computed: true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func NewUserDataSource() datasource.DataSource {
+	return &userDataSource{}
+}
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ell/terraform-provider-twitch/internal/helix"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &userDescriptionResource{}
+	_ resource.ResourceWithConfigure   = &userDescriptionResource{}
+	_ resource.ResourceWithImportState = &userDescriptionResource{}
+)
+
+type userDescriptionResource struct {
+	TwitchClient *helix.Client
+}
+
+func (u *userDescriptionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	twitchClient, ok := req.ProviderData.(*helix.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *helix.Client, got %T", req.ProviderData))
+
+		return
+	}
+
+	u.TwitchClient = twitchClient
+}
+
+func NewUserDescriptionResource() resource.Resource {
+	return &userDescriptionResource{}
+}
+
+func (u *userDescriptionResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the channel description (bio) of the user the access token belongs to. " +
+			"Requires the `user:edit` scope. Destroying the resource leaves the description in place.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "User whose description is managed. Must be the token owner; defaults to it.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(300),
+				},
+			},
+		},
+	}
+}
+
+type userDescriptionResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	UserID      types.String `tfsdk:"user_id"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (u *userDescriptionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_description"
+}
+
+// updateDescription checks that the plan targets the token owner before
+// updating, since Helix can only edit the description of that user.
+func (u *userDescriptionResource) updateDescription(plan *userDescriptionResourceModel) error {
+	users, err := u.TwitchClient.GetUsers(nil, nil)
+	if err != nil {
+		return err
+	}
+
+	if len(users) == 0 {
+		return fmt.Errorf("the access token does not belong to a user; a user access token is required")
+	}
+
+	owner := users[0]
+	if !plan.UserID.IsUnknown() && !plan.UserID.IsNull() && plan.UserID.ValueString() != owner.ID {
+		return fmt.Errorf("user_id %s does not match the token owner %s (%s); only the token owner's description can be updated",
+			plan.UserID.ValueString(), owner.ID, owner.Login)
+	}
+
+	user, err := u.TwitchClient.UpdateUserDescription(plan.Description.ValueString())
+	if err != nil {
+		return err
+	}
+
+	plan.ID = types.StringValue(user.ID)
+	plan.UserID = types.StringValue(user.ID)
+	plan.Description = types.StringValue(user.Description)
+
+	return nil
+}
+
+func (u *userDescriptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan userDescriptionResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := u.updateDescription(&plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update user description", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (u *userDescriptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state userDescriptionResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := u.TwitchClient.GetUsers([]string{state.ID.ValueString()}, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get user", err.Error())
+		return
+	}
+
+	if len(users) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.UserID = types.StringValue(users[0].ID)
+	state.Description = types.StringValue(users[0].Description)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (u *userDescriptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan userDescriptionResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := u.updateDescription(&plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update user description", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete leaves the description as it is, like twitch_channel does.
+func (u *userDescriptionResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+func (u *userDescriptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/ell/terraform-provider-twitch/internal/helix"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = &usersDataSource{}
+	_ datasource.DataSourceWithConfigure        = &usersDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &usersDataSource{}
+)
+
+type usersDataSource struct {
+	client *helix.Client
+}
+
+type usersDataSourceModel struct {
+	IDs    []types.String        `tfsdk:"ids"`
+	Logins []types.String        `tfsdk:"logins"`
+	Users  []userDataSourceModel `tfsdk:"users"`
+}
+
+func (u *usersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*helix.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected DataSource Configure Type", "Expected *helix.Client")
+
+		return
+	}
+
+	u.client = client
+}
+
+func (u *usersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (u *usersDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("ids"),
+			path.MatchRoot("logins"),
+		),
+	}
+}
+
+func (u *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state usersDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := []string{}
+	for _, id := range state.IDs {
+		ids = append(ids, id.ValueString())
+	}
+
+	logins := []string{}
+	for _, login := range state.Logins {
+		logins = append(logins, login.ValueString())
+	}
+
+	// Without any lookups Get Users would return the token owner instead.
+	state.Users = []userDataSourceModel{}
+	if len(ids) == 0 && len(logins) == 0 {
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+
+		return
+	}
+
+	users, err := u.client.GetUsers(ids, logins)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to get users", err.Error())
+
+		return
+	}
+
+	for _, user := range users {
+		state.Users = append(state.Users, newUserDataSourceModel(user))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (u *usersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up users by ID and login, 100 per request. Users that don't exist are left out of `users`.",
+		Attributes: map[string]schema.Attribute{
+			"ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"logins": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"users": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"login": schema.StringAttribute{
+							Computed: true,
+						},
+						"display_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"type": schema.StringAttribute{
+							Computed: true,
+						},
+						"broadcaster_type": schema.StringAttribute{
+							Computed: true,
+						},
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+						"profile_image_url": schema.StringAttribute{
+							Computed: true,
+						},
+						"offline_image_url": schema.StringAttribute{
+							Computed: true,
+						},
+						"created_at": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func NewUsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
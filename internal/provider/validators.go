@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+	// Embedded so time zones validate the same way regardless of the host.
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// httpsURLRegexp matches the callback URLs Twitch accepts for webhooks.
+var httpsURLRegexp = regexp.MustCompile(`^https://[^\s/]+(/\S*)?$`)
+
+// numericRegexp matches non-negative integers passed to Twitch as strings.
+var numericRegexp = regexp.MustCompile(`^[0-9]+$`)
+
+// hexColorRegexp matches the #RRGGBB colors Twitch accepts for rewards.
+var hexColorRegexp = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+// maxTagLength is the longest channel tag Twitch accepts, in characters.
+const maxTagLength = 25
+
+// isTagRune reports whether r may appear in a channel tag. Twitch rejects
+// spaces and special characters.
+func isTagRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+var _ validator.String = rfc3339Validator{}
+
+// rfc3339Validator checks that a string is an RFC3339 timestamp.
+type rfc3339Validator struct{}
+
+func (v rfc3339Validator) Description(_ context.Context) string {
+	return "value must be an RFC3339 timestamp, for example 2024-05-01T18:00:00Z"
+}
+
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rfc3339Validator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	_, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Timestamp",
+			fmt.Sprintf("Attribute %s %s, got %q", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+var _ validator.String = timezoneValidator{}
+
+// timezoneValidator checks that a string is an IANA time zone name.
+type timezoneValidator struct{}
+
+func (v timezoneValidator) Description(_ context.Context) string {
+	return "value must be an IANA time zone name, for example America/New_York"
+}
+
+func (v timezoneValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v timezoneValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	timezone := req.ConfigValue.ValueString()
+
+	// LoadLocation maps "" to UTC and "Local" to the machine's zone, neither of
+	// which Twitch accepts.
+	valid := timezone != "" && timezone != "Local"
+	if valid {
+		_, err := time.LoadLocation(timezone)
+		valid = err == nil
+	}
+
+	if !valid {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Time Zone",
+			fmt.Sprintf("Attribute %s %s, got %q", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+var _ validator.String = durationValidator{}
+
+// durationValidator checks that a string is a Go duration such as "90s" or "5m".
+type durationValidator struct{}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return "value must be a duration, for example 90s or 5m"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	duration, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || duration <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Attribute %s %s, got %q", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+var _ validator.String = channelTagValidator{}
+
+// channelTagValidator checks that a tag only has letters and digits.
+type channelTagValidator struct{}
+
+func (v channelTagValidator) Description(_ context.Context) string {
+	return "value must only contain letters and digits, without spaces or special characters"
+}
+
+func (v channelTagValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v channelTagValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, r := range req.ConfigValue.ValueString() {
+		if !isTagRune(r) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid Tag",
+				fmt.Sprintf("Attribute %s %s, got %q", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+			)
+
+			return
+		}
+	}
+}
+
+var _ validator.Set = uniqueTagsValidator{}
+
+// uniqueTagsValidator checks that no two tags differ only in case, which
+// Twitch treats as duplicates.
+type uniqueTagsValidator struct{}
+
+func (v uniqueTagsValidator) Description(_ context.Context) string {
+	return "tags must be unique, ignoring case"
+}
+
+func (v uniqueTagsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v uniqueTagsValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	seen := map[string]string{}
+
+	for _, element := range req.ConfigValue.Elements() {
+		tag, ok := element.(types.String)
+		if !ok || tag.IsNull() || tag.IsUnknown() {
+			continue
+		}
+
+		folded := strings.ToLower(tag.ValueString())
+		if previous, ok := seen[folded]; ok {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Duplicate Tag",
+				fmt.Sprintf("Attribute %s %s, got %q and %q", req.Path, v.Description(ctx), previous, tag.ValueString()),
+			)
+
+			continue
+		}
+
+		seen[folded] = tag.ValueString()
+	}
+}